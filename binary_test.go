@@ -0,0 +1,255 @@
+package linkedlist
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"testing"
+
+	bytelist "github.com/itsmontoya/linkedlist/typed/byteslice"
+	int32list "github.com/itsmontoya/linkedlist/typed/int32"
+	stringlist "github.com/itsmontoya/linkedlist/typed/string"
+)
+
+func TestLinkedListBinary(t *testing.T) {
+	var l LinkedList
+	l.Append(nil, true, 1, int32(-2), int64(3), 4.5, "five", []byte("six"))
+
+	bs, err := l.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var nl LinkedList
+	if err = nl.UnmarshalBinary(bs); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := l.Slice()
+	received := nl.Slice()
+	if len(received) != len(expected) {
+		t.Fatalf("invalid length, expected %d and received %d", len(expected), len(received))
+	}
+
+	for i, val := range expected {
+		if b, ok := val.([]byte); ok {
+			if !bytes.Equal(b, received[i].([]byte)) {
+				t.Fatalf("invalid value at index %d, expected %v and received %v", i, val, received[i])
+			}
+
+			continue
+		}
+
+		if received[i] != val {
+			t.Fatalf("invalid value at index %d, expected %v (%T) and received %v (%T)", i, val, val, received[i], received[i])
+		}
+	}
+
+	var invalid LinkedList
+	invalid.Append(struct{}{})
+	if _, err = invalid.MarshalBinary(); err == nil {
+		t.Fatal("expected an error when encoding an unsupported type")
+	}
+}
+
+func TestTypedListBinary(t *testing.T) {
+	var il int32list.LinkedList
+	il.Append(0, -1, 1, -64, 63)
+
+	bs, err := il.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Length prefix and five single byte zig-zag varints
+	if len(bs) != 6 {
+		t.Fatalf("invalid encoded length, expected %d and received %d", 6, len(bs))
+	}
+
+	var nl int32list.LinkedList
+	if err = nl.UnmarshalBinary(bs); err != nil {
+		t.Fatal(err)
+	}
+
+	if nl.Len() != 5 || nl.Slice()[3] != -64 {
+		t.Fatalf("invalid values, expected %v and received %v", il.Slice(), nl.Slice())
+	}
+
+	// Unmarshaling into a populated list replaces its values
+	if err = nl.UnmarshalBinary(bs); err != nil {
+		t.Fatal(err)
+	}
+
+	if nl.Len() != 5 {
+		t.Fatalf("invalid length, expected %d and received %d", 5, nl.Len())
+	}
+
+	// A failed unmarshal leaves the list unchanged
+	if err = nl.UnmarshalBinary(bs[:3]); err != io.ErrUnexpectedEOF {
+		t.Fatalf("invalid error, expected %v and received %v", io.ErrUnexpectedEOF, err)
+	}
+
+	if nl.Len() != 5 {
+		t.Fatalf("invalid length, expected %d and received %d", 5, nl.Len())
+	}
+
+	// Ensure the stream remains positioned after the list for following data
+	var (
+		sl  stringlist.LinkedList
+		buf bytes.Buffer
+	)
+
+	sl.Append("hello", "", "world")
+	if _, err = sl.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	buf.WriteString("trailing")
+
+	var nsl stringlist.LinkedList
+	n, err := nsl.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != 14 {
+		t.Fatalf("invalid read count, expected %d and received %d", 14, n)
+	}
+
+	if buf.String() != "trailing" {
+		t.Fatalf("invalid remaining data, expected %v and received %v", "trailing", buf.String())
+	}
+
+	if err = testCompareStrings(nsl.Slice(), sl.Slice()); err != nil {
+		t.Fatal(err)
+	}
+
+	var bl bytelist.LinkedList
+	bl.Append([]byte("abc"))
+	if bs, err = bl.MarshalBinary(); err != nil {
+		t.Fatal(err)
+	}
+
+	var nbl bytelist.LinkedList
+	if err = nbl.UnmarshalBinary(bs[:len(bs)-1]); err != io.ErrUnexpectedEOF {
+		t.Fatalf("invalid error, expected %v and received %v", io.ErrUnexpectedEOF, err)
+	}
+}
+
+func TestLinkedListBinaryCorrupt(t *testing.T) {
+	// One value with a length prefix far larger than the payload
+	corrupt := []byte{1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}
+
+	var sl stringlist.LinkedList
+	if err := sl.UnmarshalBinary(corrupt); err != io.ErrUnexpectedEOF {
+		t.Fatalf("invalid error, expected %v and received %v", io.ErrUnexpectedEOF, err)
+	}
+
+	var bl bytelist.LinkedList
+	if err := bl.UnmarshalBinary(corrupt); err != io.ErrUnexpectedEOF {
+		t.Fatalf("invalid error, expected %v and received %v", io.ErrUnexpectedEOF, err)
+	}
+
+	// Length prefix which cannot be represented as an int64
+	overflow := []byte{1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}
+	if err := bl.UnmarshalBinary(overflow); err != bytelist.ErrInvalidBinaryLength {
+		t.Fatalf("invalid error, expected %v and received %v", bytelist.ErrInvalidBinaryLength, err)
+	}
+
+	var l LinkedList
+	if err := l.UnmarshalBinary([]byte{1, binaryTagString, 0xff, 0xff, 0x03}); err != io.ErrUnexpectedEOF {
+		t.Fatalf("invalid error, expected %v and received %v", io.ErrUnexpectedEOF, err)
+	}
+}
+
+func TestLinkedListBinaryValueRange(t *testing.T) {
+	// One value which exceeds the range of an int32
+	overflow := []byte{1, 0xfe, 0xff, 0xff, 0xff, 0x1f}
+
+	var nl int32list.LinkedList
+	if err := nl.UnmarshalBinary(overflow); err != int32list.ErrBinaryValueRange {
+		t.Fatalf("invalid error, expected %v and received %v", int32list.ErrBinaryValueRange, err)
+	}
+
+	if nl.Len() != 0 {
+		t.Fatalf("invalid length, expected %v and received %v", 0, nl.Len())
+	}
+
+	// Boundary values remain valid
+	nl.Append(math.MinInt32, math.MaxInt32)
+	bs, err := nl.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dl int32list.LinkedList
+	if err = dl.UnmarshalBinary(bs); err != nil {
+		t.Fatal(err)
+	}
+
+	if s := dl.Slice(); len(s) != 2 || s[0] != math.MinInt32 || s[1] != math.MaxInt32 {
+		t.Fatalf("invalid value, expected %v and received %v", nl.Slice(), s)
+	}
+}
+
+func TestLinkedListReadFromUnbuffered(t *testing.T) {
+	var sl stringlist.LinkedList
+	sl.Append("hello", "world")
+	bs, err := sl.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reader which does not implement io.ByteReader, such as a net.Conn
+	r := io.MultiReader(bytes.NewReader(bs), bytes.NewReader([]byte("trailing")))
+
+	var nsl stringlist.LinkedList
+	if _, err = nsl.ReadFrom(r); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = testCompareStrings(nsl.Slice(), sl.Slice()); err != nil {
+		t.Fatal(err)
+	}
+
+	remaining, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(remaining) != "trailing" {
+		t.Fatalf("invalid remaining data, expected %v and received %v", "trailing", string(remaining))
+	}
+}
+
+func testCompareStrings(received, expected []string) (err error) {
+	if len(received) != len(expected) {
+		return fmt.Errorf("invalid length, expected %d and received %d", len(expected), len(received))
+	}
+
+	for i, val := range expected {
+		if received[i] != val {
+			return fmt.Errorf("invalid value at index %d, expected %q and received %q", i, val, received[i])
+		}
+	}
+
+	return
+}
+
+func BenchmarkInt32ListMarshalBinary(b *testing.B) {
+	var l int32list.LinkedList
+	for i := 0; i < 1024; i++ {
+		l.Append(int32(i))
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := l.MarshalBinary(); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportAllocs()
+}
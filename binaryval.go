@@ -0,0 +1,138 @@
+package linkedlist
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+const (
+	binaryTagNil byte = iota
+	binaryTagBool
+	binaryTagInt
+	binaryTagInt32
+	binaryTagInt64
+	binaryTagFloat64
+	binaryTagString
+	binaryTagBytes
+)
+
+// ErrInvalidBinaryTag is returned when decoding a value with an unknown type tag
+var ErrInvalidBinaryTag = errors.New("invalid binary type tag")
+
+// writeBinaryVal will write a type tag followed by the encoded value
+// Note: The generic list supports nil, bool, int, int32, int64, float64, string and []byte values
+func writeBinaryVal(w io.Writer, buf []byte, val GenericVal) (err error) {
+	var (
+		tag byte
+		n   int
+		bs  []byte
+	)
+
+	switch v := val.(type) {
+	case nil:
+		tag = binaryTagNil
+	case bool:
+		tag = binaryTagBool
+		if buf[0], n = 0, 1; v {
+			buf[0] = 1
+		}
+	case int:
+		tag = binaryTagInt
+		n = binary.PutVarint(buf, int64(v))
+	case int32:
+		tag = binaryTagInt32
+		n = binary.PutVarint(buf, int64(v))
+	case int64:
+		tag = binaryTagInt64
+		n = binary.PutVarint(buf, v)
+	case float64:
+		tag = binaryTagFloat64
+		binary.LittleEndian.PutUint64(buf, math.Float64bits(v))
+		n = 8
+	case string:
+		tag = binaryTagString
+		n = binary.PutUvarint(buf, uint64(len(v)))
+		bs = []byte(v)
+	case []byte:
+		tag = binaryTagBytes
+		n = binary.PutUvarint(buf, uint64(len(v)))
+		bs = v
+
+	default:
+		return fmt.Errorf("unsupported binary type %T", val)
+	}
+
+	if _, err = w.Write([]byte{tag}); err != nil {
+		return
+	}
+
+	if _, err = w.Write(buf[:n]); err != nil {
+		return
+	}
+
+	_, err = w.Write(bs)
+	return
+}
+
+// readBinaryVal will read a type tag followed by the encoded value
+func readBinaryVal(r binaryReader) (val GenericVal, err error) {
+	var tag byte
+	if tag, err = r.ReadByte(); err != nil {
+		return
+	}
+
+	switch tag {
+	case binaryTagNil:
+		return nil, nil
+	case binaryTagBool:
+		var b byte
+		if b, err = r.ReadByte(); err != nil {
+			return
+		}
+
+		return b == 1, nil
+	case binaryTagInt, binaryTagInt32, binaryTagInt64:
+		var v int64
+		if v, err = binary.ReadVarint(r); err != nil {
+			return
+		}
+
+		switch tag {
+		case binaryTagInt:
+			return int(v), nil
+		case binaryTagInt32:
+			return int32(v), nil
+		default:
+			return v, nil
+		}
+	case binaryTagFloat64:
+		bs := make([]byte, 8)
+		if _, err = io.ReadFull(r, bs); err != nil {
+			return
+		}
+
+		return math.Float64frombits(binary.LittleEndian.Uint64(bs)), nil
+	case binaryTagString, binaryTagBytes:
+		var length uint64
+		if length, err = binary.ReadUvarint(r); err != nil {
+			return
+		}
+
+		var bs []byte
+		if bs, err = readBinaryBytes(r, length); err != nil {
+			return
+		}
+
+		if tag == binaryTagString {
+			return string(bs), nil
+		}
+
+		return bs, nil
+
+	default:
+		return nil, ErrInvalidBinaryTag
+	}
+}
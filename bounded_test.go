@@ -0,0 +1,79 @@
+package linkedlist
+
+import "testing"
+
+func TestBoundedListOverwriteOldest(t *testing.T) {
+	var evicted []int
+	l := NewBoundedList(3, OverwriteOldest, func(val GenericVal) {
+		evicted = append(evicted, val.(int))
+	})
+
+	if appended := l.Append(0, 1, 2, 3, 4); appended != 5 {
+		t.Fatalf("invalid appended count, expected %v and received %v", 5, appended)
+	}
+
+	if l.Len() != 3 {
+		t.Fatalf("invalid length, expected %v and received %v", 3, l.Len())
+	}
+
+	if err := testCompareInts(testToInts(l.Slice()), []int{2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(evicted, []int{0, 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	var reversed []int
+	l.ForEachRev(nil, func(_ *Node, val GenericVal) bool {
+		reversed = append(reversed, val.(int))
+		return false
+	})
+
+	if err := testCompareInts(reversed, []int{4, 3, 2}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBoundedListRejectNewest(t *testing.T) {
+	l := NewBoundedList(2, RejectNewest, nil)
+	if appended := l.Append(0, 1, 2); appended != 2 {
+		t.Fatalf("invalid appended count, expected %v and received %v", 2, appended)
+	}
+
+	if err := testCompareInts(testToInts(l.Slice()), []int{0, 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Removing a node should free capacity for a new value
+	l.ForEach(nil, func(n *Node, _ GenericVal) bool {
+		l.Remove(n)
+		return true
+	})
+
+	if appended := l.Append(3); appended != 1 {
+		t.Fatalf("invalid appended count, expected %v and received %v", 1, appended)
+	}
+
+	if err := testCompareInts(testToInts(l.Slice()), []int{1, 3}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testToInts(vals []GenericVal) (s []int) {
+	s = make([]int, 0, len(vals))
+	for _, val := range vals {
+		s = append(s, val.(int))
+	}
+
+	return
+}
+
+func BenchmarkBoundedListAppend(b *testing.B) {
+	l := NewBoundedList(1024, OverwriteOldest, nil)
+	for i := 0; i < b.N; i++ {
+		l.Append(i)
+	}
+
+	b.ReportAllocs()
+}
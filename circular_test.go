@@ -0,0 +1,147 @@
+package linkedlist
+
+import "testing"
+
+func TestCircularList(t *testing.T) {
+	var l CircularList
+	l.Append(1, 2, 3)
+	l.Prepend(0)
+	if l.Len() != 4 {
+		t.Fatalf("invalid length, expected %v and received %v", 4, l.Len())
+	}
+
+	head := l.Head()
+	if tail := l.Prev(head); tail.val.(int) != 3 {
+		t.Fatalf("invalid tail, expected %v and received %v", 3, tail.val)
+	}
+
+	if n := l.Next(l.Prev(head)); n != head {
+		t.Fatal("expected the tail to link to the head")
+	}
+
+	if n := l.Advance(head, 6); n.val.(int) != 2 {
+		t.Fatalf("invalid value, expected %v and received %v", 2, n.val)
+	}
+
+	if n := l.Advance(head, -1); n.val.(int) != 3 {
+		t.Fatalf("invalid value, expected %v and received %v", 3, n.val)
+	}
+
+	// Iterate starting from the third node, ensuring each node is visited once
+	var visited []int
+	l.ForEach(l.Advance(head, 2), func(_ *Node, val GenericVal) bool {
+		visited = append(visited, val.(int))
+		return false
+	})
+
+	if err := testCompareInts(visited, []int{2, 3, 0, 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	visited = visited[:0]
+	l.ForEachRev(nil, func(_ *Node, val GenericVal) bool {
+		visited = append(visited, val.(int))
+		return false
+	})
+
+	if err := testCompareInts(visited, []int{3, 2, 1, 0}); err != nil {
+		t.Fatal(err)
+	}
+
+	l.ForEach(nil, func(n *Node, _ GenericVal) bool {
+		l.Remove(n)
+		return false
+	})
+
+	if l.Len() != 0 || l.Head() != nil {
+		t.Fatalf("invalid length, expected %v and received %v", 0, l.Len())
+	}
+}
+
+func TestCircularListRoundRobin(t *testing.T) {
+	var l CircularList
+	if _, ok := l.RoundRobinVal(); ok {
+		t.Fatal("expected round robin on an empty list to fail")
+	}
+
+	l.Append(0, 1, 2)
+
+	var selected []int
+	for i := 0; i < 7; i++ {
+		val, _ := l.RoundRobinVal()
+		selected = append(selected, val.(int))
+	}
+
+	if err := testCompareInts(selected, []int{0, 1, 2, 0, 1, 2, 0}); err != nil {
+		t.Fatal(err)
+	}
+
+	l.Rotate(-1)
+	if val := l.Head().val.(int); val != 0 {
+		t.Fatalf("invalid head, expected %v and received %v", 0, val)
+	}
+}
+
+func TestCircularListForEachRemove(t *testing.T) {
+	var l CircularList
+	l.Append(1, 2, 3, 4)
+
+	// Remove the upcoming node from within the func, ensuring removed nodes are skipped
+	var visited []int
+	l.ForEach(nil, func(n *Node, val GenericVal) bool {
+		visited = append(visited, val.(int))
+		l.Remove(n.next)
+		return false
+	})
+
+	if err := testCompareInts(visited, []int{1, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testCircularInts(&l), []int{1, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	l = CircularList{}
+	l.Append(1, 2, 3, 4)
+	visited = visited[:0]
+	l.ForEachRev(nil, func(n *Node, val GenericVal) bool {
+		visited = append(visited, val.(int))
+		l.Remove(n.prev)
+		return false
+	})
+
+	if err := testCompareInts(visited, []int{4, 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testCircularInts(&l), []int{2, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove the starting node from within the func, ensuring the remaining nodes are visited once
+	l = CircularList{}
+	l.Append(1, 2, 3, 4)
+	head := l.Head()
+	visited = visited[:0]
+	l.ForEach(l.Next(head), func(n *Node, val GenericVal) bool {
+		visited = append(visited, val.(int))
+		if val.(int) == 3 {
+			l.Remove(head)
+		}
+
+		return false
+	})
+
+	if err := testCompareInts(visited, []int{2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testCircularInts(l *CircularList) (s []int) {
+	for _, val := range l.Slice() {
+		s = append(s, val.(int))
+	}
+
+	return
+}
@@ -0,0 +1,53 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package convert
+
+// MapByteSliceToInt will return a list of the mapped values, preserving order
+func MapByteSliceToInt(l *ByteSliceList, fn func(val ByteSlice) (nval int)) (nl *IntList) {
+	nl = &IntList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *ByteSliceNode, val ByteSlice) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapByteSliceToInt32 will return a list of the mapped values, preserving order
+func MapByteSliceToInt32(l *ByteSliceList, fn func(val ByteSlice) (nval int32)) (nl *Int32List) {
+	nl = &Int32List{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *ByteSliceNode, val ByteSlice) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapByteSliceToInt64 will return a list of the mapped values, preserving order
+func MapByteSliceToInt64(l *ByteSliceList, fn func(val ByteSlice) (nval int64)) (nl *Int64List) {
+	nl = &Int64List{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *ByteSliceNode, val ByteSlice) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapByteSliceToString will return a list of the mapped values, preserving order
+func MapByteSliceToString(l *ByteSliceList, fn func(val ByteSlice) (nval string)) (nl *StringList) {
+	nl = &StringList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *ByteSliceNode, val ByteSlice) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
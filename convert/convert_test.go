@@ -0,0 +1,31 @@
+package convert
+
+import (
+	"strconv"
+	"testing"
+
+	intlist "github.com/itsmontoya/linkedlist/typed/int"
+	stringlist "github.com/itsmontoya/linkedlist/typed/string"
+)
+
+func TestMapIntToString(t *testing.T) {
+	l := intlist.New(3, 1, 2)
+	nl := MapIntToString(l, func(val int) string {
+		return "id:" + strconv.Itoa(val)
+	})
+
+	if str := nl.Join(","); str != "id:3,id:1,id:2" {
+		t.Fatalf("invalid value, expected %v and received %v", "id:3,id:1,id:2", str)
+	}
+}
+
+func TestMapStringToByteSlice(t *testing.T) {
+	l := stringlist.New("a", "bc")
+	nl := MapStringToByteSlice(l, func(val string) []byte {
+		return []byte(val)
+	})
+
+	if nl.Len() != 2 || string(nl.Slice()[1]) != "bc" {
+		t.Fatalf("invalid values, received %v", nl.Slice())
+	}
+}
@@ -0,0 +1,53 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package convert
+
+// MapIntToInt32 will return a list of the mapped values, preserving order
+func MapIntToInt32(l *IntList, fn func(val int) (nval int32)) (nl *Int32List) {
+	nl = &Int32List{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *IntNode, val int) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapIntToInt64 will return a list of the mapped values, preserving order
+func MapIntToInt64(l *IntList, fn func(val int) (nval int64)) (nl *Int64List) {
+	nl = &Int64List{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *IntNode, val int) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapIntToString will return a list of the mapped values, preserving order
+func MapIntToString(l *IntList, fn func(val int) (nval string)) (nl *StringList) {
+	nl = &StringList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *IntNode, val int) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapIntToByteSlice will return a list of the mapped values, preserving order
+func MapIntToByteSlice(l *IntList, fn func(val int) (nval ByteSlice)) (nl *ByteSliceList) {
+	nl = &ByteSliceList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *IntNode, val int) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
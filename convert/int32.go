@@ -0,0 +1,53 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package convert
+
+// MapInt32ToInt will return a list of the mapped values, preserving order
+func MapInt32ToInt(l *Int32List, fn func(val int32) (nval int)) (nl *IntList) {
+	nl = &IntList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Int32Node, val int32) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapInt32ToInt64 will return a list of the mapped values, preserving order
+func MapInt32ToInt64(l *Int32List, fn func(val int32) (nval int64)) (nl *Int64List) {
+	nl = &Int64List{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Int32Node, val int32) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapInt32ToString will return a list of the mapped values, preserving order
+func MapInt32ToString(l *Int32List, fn func(val int32) (nval string)) (nl *StringList) {
+	nl = &StringList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Int32Node, val int32) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapInt32ToByteSlice will return a list of the mapped values, preserving order
+func MapInt32ToByteSlice(l *Int32List, fn func(val int32) (nval ByteSlice)) (nl *ByteSliceList) {
+	nl = &ByteSliceList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Int32Node, val int32) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
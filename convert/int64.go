@@ -0,0 +1,53 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package convert
+
+// MapInt64ToInt will return a list of the mapped values, preserving order
+func MapInt64ToInt(l *Int64List, fn func(val int64) (nval int)) (nl *IntList) {
+	nl = &IntList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Int64Node, val int64) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapInt64ToInt32 will return a list of the mapped values, preserving order
+func MapInt64ToInt32(l *Int64List, fn func(val int64) (nval int32)) (nl *Int32List) {
+	nl = &Int32List{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Int64Node, val int64) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapInt64ToString will return a list of the mapped values, preserving order
+func MapInt64ToString(l *Int64List, fn func(val int64) (nval string)) (nl *StringList) {
+	nl = &StringList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Int64Node, val int64) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapInt64ToByteSlice will return a list of the mapped values, preserving order
+func MapInt64ToByteSlice(l *Int64List, fn func(val int64) (nval ByteSlice)) (nl *ByteSliceList) {
+	nl = &ByteSliceList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Int64Node, val int64) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
@@ -0,0 +1,53 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package convert
+
+// MapStringToInt will return a list of the mapped values, preserving order
+func MapStringToInt(l *StringList, fn func(val string) (nval int)) (nl *IntList) {
+	nl = &IntList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *StringNode, val string) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapStringToInt32 will return a list of the mapped values, preserving order
+func MapStringToInt32(l *StringList, fn func(val string) (nval int32)) (nl *Int32List) {
+	nl = &Int32List{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *StringNode, val string) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapStringToInt64 will return a list of the mapped values, preserving order
+func MapStringToInt64(l *StringList, fn func(val string) (nval int64)) (nl *Int64List) {
+	nl = &Int64List{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *StringNode, val string) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// MapStringToByteSlice will return a list of the mapped values, preserving order
+func MapStringToByteSlice(l *StringList, fn func(val string) (nval ByteSlice)) (nl *ByteSliceList) {
+	nl = &ByteSliceList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *StringNode, val string) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
@@ -0,0 +1,19 @@
+package convert
+
+//go:generate genny -in=$GOFILE -out=int.go gen "GenericFrom=int GenericTo=int32,int64,string,ByteSlice"
+//go:generate genny -in=$GOFILE -out=int32.go gen "GenericFrom=int32 GenericTo=int,int64,string,ByteSlice"
+//go:generate genny -in=$GOFILE -out=int64.go gen "GenericFrom=int64 GenericTo=int,int32,string,ByteSlice"
+//go:generate genny -in=$GOFILE -out=string.go gen "GenericFrom=string GenericTo=int,int32,int64,ByteSlice"
+//go:generate genny -in=$GOFILE -out=byteslice.go gen "GenericFrom=ByteSlice GenericTo=int,int32,int64,string"
+
+// MapGenericFromToGenericTo will return a list of the mapped values, preserving order
+func MapGenericFromToGenericTo(l *GenericFromList, fn func(val GenericFrom) (nval GenericTo)) (nl *GenericToList) {
+	nl = &GenericToList{}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *GenericFromNode, val GenericFrom) bool {
+		nl.Append(fn(val))
+		return false
+	})
+
+	return
+}
@@ -0,0 +1,52 @@
+// Package convert provides functions which map a typed list into a list of a different element type
+package convert
+
+import (
+	"github.com/itsmontoya/linkedlist"
+	bytelist "github.com/itsmontoya/linkedlist/typed/byteslice"
+	intlist "github.com/itsmontoya/linkedlist/typed/int"
+	int32list "github.com/itsmontoya/linkedlist/typed/int32"
+	int64list "github.com/itsmontoya/linkedlist/typed/int64"
+	stringlist "github.com/itsmontoya/linkedlist/typed/string"
+)
+
+// Template types, these allow the converter template to compile against the generic list
+type (
+	// GenericFrom is the generic value type of the source list
+	GenericFrom = linkedlist.GenericVal
+	// GenericFromList is the generic source list
+	GenericFromList = linkedlist.LinkedList
+	// GenericFromNode is the generic source node
+	GenericFromNode = linkedlist.Node
+	// GenericTo is the generic value type of the destination list
+	GenericTo = linkedlist.GenericVal
+	// GenericToList is the generic destination list
+	GenericToList = linkedlist.LinkedList
+)
+
+// ByteSlice is the value type of the byteslice list
+type ByteSlice = []byte
+
+// Typed lists and nodes
+type (
+	// IntList is a list of int values
+	IntList = intlist.LinkedList
+	// IntNode is a node of an int list
+	IntNode = intlist.Node
+	// Int32List is a list of int32 values
+	Int32List = int32list.LinkedList
+	// Int32Node is a node of an int32 list
+	Int32Node = int32list.Node
+	// Int64List is a list of int64 values
+	Int64List = int64list.LinkedList
+	// Int64Node is a node of an int64 list
+	Int64Node = int64list.Node
+	// StringList is a list of string values
+	StringList = stringlist.LinkedList
+	// StringNode is a node of a string list
+	StringNode = stringlist.Node
+	// ByteSliceList is a list of []byte values
+	ByteSliceList = bytelist.LinkedList
+	// ByteSliceNode is a node of a []byte list
+	ByteSliceNode = bytelist.Node
+)
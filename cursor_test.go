@@ -0,0 +1,131 @@
+package linkedlist
+
+import "testing"
+
+func TestCursor(t *testing.T) {
+	l := New(1, 2, 3)
+	c := l.Cursor(nil)
+	if _, ok := c.Value(); ok {
+		t.Fatal("expected cursor before the head to have no value")
+	}
+
+	// Walk forward past the tail
+	var vals []int
+	for c.Next() {
+		val, _ := c.Value()
+		vals = append(vals, val.(int))
+	}
+
+	if err := testCompareInts(vals, []int{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Step back onto the tail and edit around it
+	if !c.Prev() {
+		t.Fatal("expected cursor to move onto the tail")
+	}
+
+	c.Set(30)
+	c.InsertBefore(25)
+	c.InsertAfter(35)
+
+	if err := testCompareInts(testToInts(l.Slice()), []int{1, 2, 25, 30, 35}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove moves to the following node, or the preceding node at the tail
+	if val, _ := c.Remove(); val != 30 {
+		t.Fatalf("invalid value, expected %v and received %v", 30, val)
+	}
+
+	if val, _ := c.Value(); val != 35 {
+		t.Fatalf("invalid value, expected %v and received %v", 35, val)
+	}
+
+	c.Remove()
+	if val, _ := c.Value(); val != 25 {
+		t.Fatalf("invalid value, expected %v and received %v", 25, val)
+	}
+
+	// Inserting at the ends of the list
+	start := l.Cursor(nil)
+	start.InsertAfter(0)
+	for start.Next() {
+	}
+
+	start.InsertBefore(40)
+	if err := testCompareInts(testToInts(l.Slice()), []int{0, 1, 2, 25, 40}); err != nil {
+		t.Fatal(err)
+	}
+
+	if l.Len() != 5 {
+		t.Fatalf("invalid length, expected %d and received %d", 5, l.Len())
+	}
+}
+
+func TestCursorConcurrentRemoval(t *testing.T) {
+	l := New(1, 2, 3, 4, 5)
+	a := l.Cursor(l.head.next)
+	b := l.Cursor(l.head.next)
+
+	// Remove 2 and 3 through a, b was positioned on 2
+	a.Remove()
+	a.Remove()
+	if val, _ := b.Value(); val != 4 {
+		t.Fatalf("invalid value, expected %v and received %v", 4, val)
+	}
+
+	// Remove the remaining tail nodes from underneath b
+	b.Next()
+	tail := l.Cursor(l.tail)
+	tail.Remove()
+	if val, _ := b.Value(); val != 4 {
+		t.Fatalf("invalid value, expected %v and received %v", 4, val)
+	}
+
+	if !b.Prev() {
+		t.Fatal("expected cursor to move onto the head")
+	}
+
+	if val, _ := b.Value(); val != 1 {
+		t.Fatalf("invalid value, expected %v and received %v", 1, val)
+	}
+
+	// Empty the list from underneath b
+	l.Remove(l.tail)
+	l.Remove(l.head)
+	if b.Node() != nil || b.Next() {
+		t.Fatal("expected cursor on an empty list to have no node")
+	}
+}
+
+func TestRemoveDetached(t *testing.T) {
+	l := New(1, 2, 3)
+	removed := l.head.next
+	l.Remove(removed)
+
+	// Insert a value where the removed node used to be
+	c := l.Cursor(l.head)
+	c.InsertAfter(9)
+
+	// Removing the node a second time must not modify the list
+	l.Remove(removed)
+	if err := testCompareInts(testToInts(l.Slice()), []int{1, 9, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if l.Len() != 3 {
+		t.Fatalf("invalid length, expected %d and received %d", 3, l.Len())
+	}
+
+	// Removing a node which belongs to another list must not modify either list
+	o := New(4, 5)
+	l.Remove(o.head)
+	if l.Len() != 3 || o.Len() != 2 {
+		t.Fatalf("invalid lengths, expected %d and %d and received %d and %d", 3, 2, l.Len(), o.Len())
+	}
+
+	if err := testCompareInts(testToInts(o.Slice()), []int{4, 5}); err != nil {
+		t.Fatal(err)
+	}
+}
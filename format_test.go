@@ -0,0 +1,67 @@
+package linkedlist
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	stringlist "github.com/itsmontoya/linkedlist/typed/string"
+)
+
+func TestLinkedListFormat(t *testing.T) {
+	l := New(1, "a", 2.5)
+
+	if str := l.String(); str != "[1 a 2.5]" {
+		t.Fatalf("invalid value, expected %q and received %q", "[1 a 2.5]", str)
+	}
+
+	if str := fmt.Sprintf("%#v", l); str != `linkedlist.New(1, "a", 2.5)` {
+		t.Fatalf("invalid value, expected %q and received %q", `linkedlist.New(1, "a", 2.5)`, str)
+	}
+
+	str := fmt.Sprintf("%+v", l)
+	if !strings.HasPrefix(str, "len=3 [0x") || !strings.HasSuffix(str, ":2.5]") {
+		t.Fatalf("invalid verbose value, received %q", str)
+	}
+
+	sl := stringlist.New("a", "b")
+	if str = fmt.Sprintf("%q", sl); str != `["a" "b"]` {
+		t.Fatalf("invalid value, expected %q and received %q", `["a" "b"]`, str)
+	}
+}
+
+func TestLinkedListFormatValue(t *testing.T) {
+	var l LinkedList
+	l.Append("a", "b", "c")
+
+	if str := fmt.Sprintf("%v", l); str != "[a b c]" {
+		t.Fatalf("invalid value, expected %q and received %q", "[a b c]", str)
+	}
+
+	if str := fmt.Sprintf("%v", &l); str != "[a b c]" {
+		t.Fatalf("invalid value, expected %q and received %q", "[a b c]", str)
+	}
+
+	if str := l.String(); str != "[a b c]" {
+		t.Fatalf("invalid value, expected %q and received %q", "[a b c]", str)
+	}
+}
+
+func TestLinkedListFormatTruncate(t *testing.T) {
+	var l LinkedList
+	for i := 0; i < DefaultFormatLimit*2; i++ {
+		l.Append(i)
+	}
+
+	if str := l.String(); !strings.HasSuffix(str, " 99 ... (200 total)]") {
+		t.Fatalf("invalid truncated value, received %q", str)
+	}
+
+	if str := fmt.Sprintf("%.3v", &l); str != "[0 1 2 ... (200 total)]" {
+		t.Fatalf("invalid value, expected %q and received %q", "[0 1 2 ... (200 total)]", str)
+	}
+
+	if str := fmt.Sprintf("%#.2v", &l); str != "linkedlist.New(0, 1 /* ... 200 total */)" {
+		t.Fatalf("invalid value, expected %q and received %q", "linkedlist.New(0, 1 /* ... 200 total */)", str)
+	}
+}
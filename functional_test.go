@@ -0,0 +1,94 @@
+package linkedlist
+
+import "testing"
+
+func TestFlatMap(t *testing.T) {
+	l := New(1, 2, 3)
+	nl := l.FlatMap(func(val GenericVal) []GenericVal {
+		return []GenericVal{val, val.(int) * 10}
+	})
+
+	if err := testCompareInts(testToInts(nl.Slice()), []int{1, 10, 2, 20, 3, 30}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTakeSkip(t *testing.T) {
+	l := New(0, 1, 2, 3, 4)
+	if err := testCompareInts(testToInts(l.Take(2).Slice()), []int{0, 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testToInts(l.Take(10).Slice()), []int{0, 1, 2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testToInts(l.Skip(3).Slice()), []int{3, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	lessThanTwo := func(val GenericVal) bool { return val.(int) < 2 }
+	if err := testCompareInts(testToInts(l.TakeWhile(lessThanTwo).Slice()), []int{0, 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testToInts(l.DropWhile(lessThanTwo).Slice()), []int{2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	matched, unmatched := New(0, 1, 2, 3, 4).Partition(testIsEven)
+	if err := testCompareInts(testToInts(matched.Slice()), []int{0, 2, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testToInts(unmatched.Slice()), []int{1, 3}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChunkWindow(t *testing.T) {
+	l := New(0, 1, 2, 3, 4)
+	chunks := l.Chunk(2)
+	if len(chunks) != 3 {
+		t.Fatalf("invalid chunk count, expected %v and received %v", 3, len(chunks))
+	}
+
+	if err := testCompareInts(testToInts(chunks[2].Slice()), []int{4}); err != nil {
+		t.Fatal(err)
+	}
+
+	windows := l.Window(3, 1)
+	if len(windows) != 3 {
+		t.Fatalf("invalid window count, expected %v and received %v", 3, len(windows))
+	}
+
+	if err := testCompareInts(testToInts(windows[1].Slice()), []int{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if windows = l.Window(2, 2); len(windows) != 2 {
+		t.Fatalf("invalid window count, expected %v and received %v", 2, len(windows))
+	}
+
+	if err := testCompareInts(testToInts(windows[1].Slice()), []int{2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if chunks = l.Chunk(0); chunks != nil {
+		t.Fatalf("invalid chunks, expected %v and received %v", nil, chunks)
+	}
+}
+
+func TestRunningReduce(t *testing.T) {
+	sums := New(1, 2, 3, 4).RunningReduce(testAddInts)
+	received := make([]int, 0, len(sums))
+	for _, sum := range sums {
+		received = append(received, sum.(int))
+	}
+
+	if err := testCompareInts(received, []int{1, 3, 6, 10}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,30 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// GobEncode will encode the list as a gob-encoded slice of values
+// Note: Concrete types which are not built-in must be registered with gob.Register
+func (l *LinkedList) GobEncode() (bs []byte, err error) {
+	var buf bytes.Buffer
+	vals := l.Slice()
+	if err = gob.NewEncoder(&buf).Encode(&vals); err != nil {
+		return
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode will decode a gob-encoded slice of values, decoded values replace the existing values of the list
+// Note: The list is left unchanged when an error is returned
+func (l *LinkedList) GobDecode(bs []byte) (err error) {
+	var vals []GenericVal
+	if err = gob.NewDecoder(bytes.NewReader(bs)).Decode(&vals); err != nil {
+		return
+	}
+
+	l.replace(New(vals...))
+	return
+}
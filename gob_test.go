@@ -0,0 +1,74 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+type testGobVal struct {
+	Name string
+	Age  int
+}
+
+func init() {
+	gob.Register(testGobVal{})
+}
+
+func TestLinkedListGob(t *testing.T) {
+	var l LinkedList
+	l.Append(1, "two", 3.5, int64(4), []byte("five"), testGobVal{Name: "six", Age: 6})
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&l); err != nil {
+		t.Fatal(err)
+	}
+
+	var nl LinkedList
+	if err := gob.NewDecoder(&buf).Decode(&nl); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected, received := l.Slice(), nl.Slice(); !reflect.DeepEqual(expected, received) {
+		t.Fatalf("invalid values, expected %#v and received %#v", expected, received)
+	}
+
+	// Decoding into a populated list replaces its values
+	bs, err := l.GobEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = nl.GobDecode(bs); err != nil {
+		t.Fatal(err)
+	}
+
+	if nl.Len() != l.Len() {
+		t.Fatalf("invalid length, expected %d and received %d", l.Len(), nl.Len())
+	}
+}
+
+func TestLinkedListGobStruct(t *testing.T) {
+	type container struct {
+		List *LinkedList
+	}
+
+	var c container
+	c.List = &LinkedList{}
+	c.List.Append(testGobVal{Name: "a", Age: 1}, uint8(2))
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&c); err != nil {
+		t.Fatal(err)
+	}
+
+	var nc container
+	if err := gob.NewDecoder(&buf).Decode(&nc); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected, received := c.List.Slice(), nc.List.Slice(); !reflect.DeepEqual(expected, received) {
+		t.Fatalf("invalid values, expected %#v and received %#v", expected, received)
+	}
+}
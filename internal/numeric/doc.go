@@ -0,0 +1,3 @@
+// Package linkedlist is the template for numeric list aggregates. The list within this package is
+// generated from the root template for GenericNumber, and only exists so the aggregates compile.
+package linkedlist
@@ -0,0 +1,354 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+var (
+	zeroVal GenericNumber
+	zeroSum GenericNumber
+)
+
+// New will return a new list populated with the provided values
+func New(vals ...GenericNumber) (l *LinkedList) {
+	l = &LinkedList{}
+	l.Append(vals...)
+	return
+}
+
+// LinkedList is a simple doubly-linked list
+type LinkedList struct {
+	head *Node
+	tail *Node
+
+	reporter bool
+	len      int32
+}
+
+// prepend will prepend the list with a value, the reference node is Returned
+func (l *LinkedList) prepend(val GenericNumber) (n *Node) {
+	n = newNode(nil, l.head, val)
+	// Set the owning list of our node
+	n.list = l
+
+	if l.head != nil {
+		// Head exists, set the previous value to our new node
+		l.head.prev = n
+	}
+
+	if l.tail == nil {
+		// This is the first item, so it will be the head AND the tail
+		l.tail = n
+	}
+
+	// Set head as our new node
+	l.head = n
+	// Increment node count
+	l.len++
+	return
+}
+
+// append will append the list with a value, the reference node is Returned
+func (l *LinkedList) append(val GenericNumber) (n *Node) {
+	n = newNode(nil, nil, val)
+	l.appendNode(n)
+	return
+}
+
+// appendNode will append the list with an unlinked node
+func (l *LinkedList) appendNode(n *Node) {
+	// Set the previous value of our node to the current tail
+	n.prev = l.tail
+	// Clear any next value retained from a previous list position
+	n.next = nil
+	// Set the owning list of our node
+	n.list = l
+
+	if l.tail != nil {
+		// Tail exists, set the next value to our new node
+		l.tail.next = n
+	}
+
+	if l.head == nil {
+		// This is the first item, so it will be the head AND the tail
+		l.head = n
+	}
+
+	// Set tail as our new node
+	l.tail = n
+	// Increment node count
+	l.len++
+}
+
+// replace will replace the nodes of the list with the nodes of the provided list, the nodes are moved rather than copied
+func (l *LinkedList) replace(nl *LinkedList) {
+	// Remove the existing nodes so cursors positioned on them remain well-defined
+	l.ForEach(nil, func(n *Node, _ GenericNumber) bool {
+		l.Remove(n)
+		return false
+	})
+
+	// Iterate through each item within the provided list
+	nl.ForEach(nil, func(n *Node, _ GenericNumber) bool {
+		nl.unlink(n)
+		l.appendNode(n)
+		return false
+	})
+}
+
+// mapCopy will return a copied and mapped list
+func (l *LinkedList) mapCopy(fn MapFn) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, val GenericNumber) bool {
+		nl.append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// mapModify will return a copied and mapped list
+func (l *LinkedList) mapModify(fn MapFn) (nl *LinkedList) {
+	nl = l
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, val GenericNumber) bool {
+		n.val = fn(val)
+		return false
+	})
+
+	return
+}
+
+// filterCopy will return a copied and filtered list
+func (l *LinkedList) filterCopy(fn FilterFn) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val GenericNumber) bool {
+		if fn(val) {
+			nl.append(val)
+		}
+
+		return false
+	})
+
+	return
+}
+
+// filterModify will modify and return filtered list
+func (l *LinkedList) filterModify(fn FilterFn) (nl *LinkedList) {
+	nl = l
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, val GenericNumber) bool {
+		if !fn(val) {
+			l.Remove(n)
+		}
+
+		return false
+	})
+
+	return
+}
+
+// Prepend will prepend the list with a value, the reference Node is Returned
+func (l *LinkedList) Prepend(vals ...GenericNumber) {
+	// Iterate through provided values
+	for _, val := range vals {
+		l.prepend(val)
+	}
+
+	return
+}
+
+// Append will append the list with a value, the reference Node is Returned
+func (l *LinkedList) Append(vals ...GenericNumber) {
+	// Iterate through provided values
+	for _, val := range vals {
+		l.append(val)
+	}
+
+	return
+}
+
+// Remove will remove a node from a list
+// Note: Nodes which do not belong to the list (eg. a node which has already been removed) are ignored
+func (l *LinkedList) Remove(n *Node) {
+	if !l.unlink(n) {
+		return
+	}
+
+	// Set node value to zero value
+	n.val = zeroVal
+}
+
+// unlink will unlink a node from a list, the node value is left intact. False is returned when the node does not belong to the list
+// Note: The prev and next values are retained so cursors positioned on a removed node can find their way back into the list
+func (l *LinkedList) unlink(n *Node) (ok bool) {
+	if n.list != l {
+		// Node does not belong to this list, its links must not be used to modify our nodes
+		return false
+	}
+
+	if n.prev != nil {
+		// Set previous node's next as our current next node
+		n.prev.next = n.next
+	} else {
+		// We have no previous, which means this is the head node
+		// Set head as the node which proceeds this one
+		if l.head = n.next; l.head != nil {
+			// Remove the previous value from our new head
+			l.head.prev = nil
+		}
+	}
+
+	if n.next != nil {
+		// Set next node's previous as our current previous node
+		n.next.prev = n.prev
+	} else {
+		// We have no next, which means this is the tail node
+		// Set tail as the node which precedes this one
+		if l.tail = n.prev; l.tail != nil {
+			// Remove the next value from our new tail
+			l.tail.next = nil
+		}
+	}
+
+	// Clear the owning list, the node no longer belongs to a list
+	n.list = nil
+	// Decrement node count
+	l.len--
+	return true
+}
+
+// ForEach will iterate through each node within the linked list
+func (l *LinkedList) ForEach(n *Node, fn ForEachFn) (ended bool) {
+	if n == nil {
+		// Provided node is nil, set to head
+		n = l.head
+	}
+
+	// Next node
+	var nn *Node
+	// Iterate until n equals nil
+	for n != nil {
+		// Set next node
+		nn = n.next
+		// Call provided func
+		if fn(n, n.val) {
+			// Func returned true, return with ended as true
+			return true
+		}
+
+		// Set n as the next node
+		n = nn
+	}
+
+	return false
+}
+
+// ForEachRev will iterate through each node within the linked list in reverse
+func (l *LinkedList) ForEachRev(n *Node, fn ForEachFn) (ended bool) {
+	if n == nil {
+		// Provided node is nil, set to tail
+		n = l.tail
+	}
+
+	// Previous node
+	var pn *Node
+	// Iterate until n equals nil
+	for n != nil {
+		// Set previous node
+		pn = n.prev
+		// Call provided func
+		if fn(n, n.val) {
+			// Func returned true, return with ended as true
+			return true
+		}
+
+		// Set n as the previous node
+		n = pn
+	}
+
+	return false
+}
+
+// Map will return a mapped list
+func (l *LinkedList) Map(fn MapFn) (nl *LinkedList) {
+	if l.reporter {
+		return l.mapModify(fn)
+	}
+
+	return l.mapCopy(fn)
+}
+
+// Filter will return a filtered list
+func (l *LinkedList) Filter(fn FilterFn) (nl *LinkedList) {
+	if l.reporter {
+		return l.filterModify(fn)
+	}
+
+	return l.filterCopy(fn)
+}
+
+// Reduce will return a reduced value
+func (l *LinkedList) Reduce(fn ReduceFn) (sum GenericNumber) {
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val GenericNumber) bool {
+		sum = fn(sum, val)
+		return false
+	})
+
+	return
+}
+
+// Slice will return a slice of the current linked list
+func (l *LinkedList) Slice() (s []GenericNumber) {
+	s = make([]GenericNumber, 0, l.len)
+	l.ForEach(nil, func(_ *Node, val GenericNumber) bool {
+		s = append(s, val)
+		return false
+	})
+
+	return
+}
+
+// Val will return the value for a given node
+func (l *LinkedList) Val(n *Node) (val GenericNumber) {
+	return n.val
+}
+
+// Update will update the value for a given node
+func (l *LinkedList) Update(n *Node, val GenericNumber) {
+	n.val = val
+}
+
+// Len will return the current length of the linked list
+func (l *LinkedList) Len() (n int32) {
+	return l.len
+}
+
+func newNode(prev, next *Node, val GenericNumber) *Node {
+	return &Node{prev: prev, next: next, val: val}
+}
+
+// Node is a value container
+type Node struct {
+	prev *Node
+	next *Node
+	// List the node is linked within, nil when the node has been removed
+	list *LinkedList
+
+	val GenericNumber
+}
+
+// ForEachFn is the format of the function used to call ForEach
+type ForEachFn func(n *Node, val GenericNumber) (end bool)
+
+// MapFn is the format of the function used to call Map
+type MapFn func(val GenericNumber) (nval GenericNumber)
+
+// FilterFn is the format of the function used to call Filter
+type FilterFn func(val GenericNumber) (ok bool)
+
+// ReduceFn is the format of the function used to call Reduce
+type ReduceFn func(acc, val GenericNumber) (sum GenericNumber)
@@ -0,0 +1,19 @@
+package linkedlist
+
+//go:generate genny -in=$GOFILE -out=../../typed/int32/$GOFILE gen "GenericNumber=int32 GenericWide=int64"
+
+import "github.com/cheekybits/genny/generic"
+
+// GenericWide is a generic numeric type which is wider than GenericNumber
+type GenericWide generic.Number
+
+// SumWide will return the sum of the values as a wider type so the sum does not overflow
+func (l *LinkedList) SumWide() (sum GenericWide) {
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val GenericNumber) bool {
+		sum += GenericWide(val)
+		return false
+	})
+
+	return
+}
@@ -0,0 +1,5 @@
+// Package linkedlist is the template for value-based set operations. These are only generated for the
+// comparable typed lists, the generic list uses the key func variants (eg. DistinctBy) instead. The list
+// within this package is generated from the root template for GenericComparable, and only exists so the
+// set operations compile.
+package linkedlist
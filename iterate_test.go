@@ -0,0 +1,85 @@
+package linkedlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestForEachErr(t *testing.T) {
+	errStop := errors.New("stop")
+	l := New(0, 1, 2, 3)
+
+	var visited int
+	err := l.ForEachErr(nil, func(_ *Node, val GenericVal) error {
+		visited++
+		if val.(int) == 1 {
+			return errStop
+		}
+
+		return nil
+	})
+
+	if err != errStop || visited != 2 {
+		t.Fatalf("invalid iteration, expected %v after %d values and received %v after %d", errStop, 2, err, visited)
+	}
+
+	nl, err := l.MapErr(func(val GenericVal) (GenericVal, error) {
+		return val.(int) * 2, nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = testCompareInts(testToInts(nl.Slice()), []int{0, 2, 4, 6}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = l.MapErr(func(val GenericVal) (GenericVal, error) {
+		return nil, errStop
+	}); err != errStop {
+		t.Fatalf("invalid error, expected %v and received %v", errStop, err)
+	}
+
+	if nl, err = l.FilterErr(func(val GenericVal) (bool, error) {
+		return val.(int) > 1, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = testCompareInts(testToInts(nl.Slice()), []int{2, 3}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestForEachCtx(t *testing.T) {
+	var l LinkedList
+	for i := 0; i < CtxCheckInterval*4; i++ {
+		l.Append(i)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var visited int
+	err := l.ForEachCtx(ctx, nil, func(_ *Node, _ GenericVal) bool {
+		if visited++; visited == CtxCheckInterval+1 {
+			cancel()
+		}
+
+		return false
+	})
+
+	if err != context.Canceled {
+		t.Fatalf("invalid error, expected %v and received %v", context.Canceled, err)
+	}
+
+	if visited != CtxCheckInterval*2 {
+		t.Fatalf("invalid visited count, expected %d and received %d", CtxCheckInterval*2, visited)
+	}
+
+	if err = l.ForEachCtx(context.Background(), nil, func(_ *Node, _ GenericVal) bool {
+		return false
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,62 @@
+package linkedlist
+
+import "testing"
+
+func TestIterator(t *testing.T) {
+	l := New(0, 1, 2, 3, 4)
+	it := l.Iterator(nil)
+
+	if val, _ := it.Peek(); val != 0 {
+		t.Fatalf("invalid value, expected %v and received %v", 0, val)
+	}
+
+	it.Next()
+	it.Mark()
+
+	var vals []int
+	for it.HasNext() {
+		val, _ := it.Next()
+		vals = append(vals, val.(int))
+	}
+
+	if err := testCompareInts(vals, []int{1, 2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := it.Next(); ok {
+		t.Fatal("expected exhausted iterator to return no value")
+	}
+
+	// Remove the marked node, reset resumes from the following node
+	l.Remove(l.head.next)
+	it.Reset()
+	if val, _ := it.Next(); val != 2 {
+		t.Fatalf("invalid value, expected %v and received %v", 2, val)
+	}
+
+	// Remove the upcoming node and the node the iterator is on
+	l.Remove(l.head.next)
+	l.Remove(l.head.next)
+	if val, _ := it.Next(); val != 4 {
+		t.Fatalf("invalid value, expected %v and received %v", 4, val)
+	}
+}
+
+func TestIteratorRev(t *testing.T) {
+	l := New(0, 1, 2, 3, 4)
+	it := l.IteratorRev(l.tail.prev)
+
+	var vals []int
+	for it.HasNext() {
+		val, _ := it.Next()
+		vals = append(vals, val.(int))
+		if val == 2 {
+			// Remove the upcoming node while iterating
+			l.Remove(l.head.next)
+		}
+	}
+
+	if err := testCompareInts(vals, []int{3, 2, 0}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,126 @@
+package linkedlist
+
+//go:generate genny -in=$GOFILE -out=typed/int/$GOFILE gen "GenericVal=int GenericSum=int"
+//go:generate genny -in=$GOFILE -out=typed/int32/$GOFILE gen "GenericVal=int32 GenericSum=int32"
+//go:generate genny -in=$GOFILE -out=typed/int64/$GOFILE gen "GenericVal=int64 GenericSum=int64"
+//go:generate genny -in=$GOFILE -out=typed/string/$GOFILE gen "GenericVal=string GenericSum=string"
+//go:generate genny -in=$GOFILE -out=typed/byteslice/$GOFILE gen "GenericVal=[]byte GenericSum=[]byte"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+var (
+	// ErrExpectedJSONArray is returned when decoding JSON which is not an array
+	ErrExpectedJSONArray = errors.New("expected JSON array")
+	// ErrJSONArrayLimit is returned when decoding a JSON array with more elements than the provided limit
+	ErrJSONArrayLimit = errors.New("JSON array exceeds element limit")
+)
+
+// DecodeJSONArray will decode a JSON array from a reader one element at a time, building the list incrementally
+// Note: A JSON null will return an empty list
+func DecodeJSONArray(r io.Reader, opts JSONArrayOpts) (l *LinkedList, err error) {
+	l = &LinkedList{}
+	if err = l.decodeJSONArray(json.NewDecoder(r), opts); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// MarshalJSON will marshal the list as a JSON array
+func (l *LinkedList) MarshalJSON() (bs []byte, err error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, val GenericVal) bool {
+		if n != l.head {
+			// This is not the first item, write a separator
+			buf.WriteByte(',')
+		}
+
+		var b []byte
+		if b, err = json.Marshal(val); err != nil {
+			return true
+		}
+
+		buf.Write(b)
+		return false
+	})
+
+	if err != nil {
+		return
+	}
+
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON will unmarshal a JSON array, decoded values replace the existing values of the list
+// Note: A JSON null will empty the list, the list is left unchanged when an error is returned
+func (l *LinkedList) UnmarshalJSON(bs []byte) (err error) {
+	var nl LinkedList
+	if err = nl.decodeJSONArray(json.NewDecoder(bytes.NewReader(bs)), JSONArrayOpts{}); err != nil {
+		return
+	}
+
+	l.replace(&nl)
+	return
+}
+
+// decodeJSONArray will decode a JSON array from a decoder, decoded values are appended to the list
+func (l *LinkedList) decodeJSONArray(dec *json.Decoder, opts JSONArrayOpts) (err error) {
+	var tkn json.Token
+	if tkn, err = dec.Token(); err != nil {
+		return
+	}
+
+	if tkn == nil {
+		// Value is null, return early
+		return
+	}
+
+	if tkn != json.Delim('[') {
+		return ErrExpectedJSONArray
+	}
+
+	// Iterate through each element within the array
+	for decoded := int32(0); dec.More(); decoded++ {
+		if opts.Limit > 0 && decoded == opts.Limit {
+			// We have reached our limit, return before decoding the next element
+			return ErrJSONArrayLimit
+		}
+
+		var val GenericVal
+		if err = dec.Decode(&val); err != nil {
+			return
+		}
+
+		if opts.OnElement != nil {
+			// Call provided func before the value is appended
+			if err = opts.OnElement(val); err != nil {
+				return
+			}
+		}
+
+		l.append(val)
+	}
+
+	// Consume the closing bracket
+	_, err = dec.Token()
+	return
+}
+
+// JSONArrayOpts are the options used when decoding a JSON array
+type JSONArrayOpts struct {
+	// Limit is the maximum number of elements to decode, zero represents no limit
+	Limit int32
+	// OnElement is called for each decoded element before it is appended, returning an error will stop decoding
+	OnElement JSONElementFn
+}
+
+// JSONElementFn is the format of the function called for each decoded JSON element
+type JSONElementFn func(val GenericVal) (err error)
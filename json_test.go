@@ -0,0 +1,162 @@
+package linkedlist
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	bytelist "github.com/itsmontoya/linkedlist/typed/byteslice"
+	intlist "github.com/itsmontoya/linkedlist/typed/int"
+	stringlist "github.com/itsmontoya/linkedlist/typed/string"
+)
+
+func TestLinkedListJSON(t *testing.T) {
+	var l LinkedList
+	l.Append("a", 1, true, nil)
+
+	bs, err := json.Marshal(&l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if str := string(bs); str != `["a",1,true,null]` {
+		t.Fatalf("invalid JSON, expected %v and received %v", `["a",1,true,null]`, str)
+	}
+
+	var nl LinkedList
+	if err = json.Unmarshal(bs, &nl); err != nil {
+		t.Fatal(err)
+	}
+
+	if nl.Len() != 4 {
+		t.Fatalf("invalid length, expected %v and received %v", 4, nl.Len())
+	}
+
+	if val := nl.Slice()[1]; val != float64(1) {
+		t.Fatalf("invalid value, expected %v and received %v", 1, val)
+	}
+
+	if err = json.Unmarshal([]byte(`{"a":1}`), &nl); err != ErrExpectedJSONArray {
+		t.Fatalf("invalid error, expected %v and received %v", ErrExpectedJSONArray, err)
+	}
+
+	if nl.Len() != 4 {
+		t.Fatalf("invalid length, expected %v and received %v", 4, nl.Len())
+	}
+
+	// Unmarshaling into a populated list replaces its values
+	if err = json.Unmarshal([]byte(`["b"]`), &nl); err != nil {
+		t.Fatal(err)
+	}
+
+	if vals := nl.Slice(); len(vals) != 1 || vals[0] != "b" {
+		t.Fatalf("invalid values, expected %v and received %v", []GenericVal{"b"}, vals)
+	}
+
+	if err = json.Unmarshal([]byte(`null`), &nl); err != nil {
+		t.Fatal(err)
+	}
+
+	if nl.Len() != 0 {
+		t.Fatalf("invalid length, expected %v and received %v", 0, nl.Len())
+	}
+
+	var empty LinkedList
+	if bs, err = json.Marshal(&empty); err != nil {
+		t.Fatal(err)
+	}
+
+	if str := string(bs); str != `[]` {
+		t.Fatalf("invalid JSON, expected %v and received %v", `[]`, str)
+	}
+}
+
+func TestTypedListJSON(t *testing.T) {
+	var il intlist.LinkedList
+	if err := json.Unmarshal([]byte(`[0, 1, 2]`), &il); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(il.Slice(), []int{0, 1, 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal([]byte(`["a"]`), &il); err == nil {
+		t.Fatal("expected an error when decoding a string into an int list")
+	}
+
+	var bl bytelist.LinkedList
+	bl.Append([]byte("hello"))
+
+	bs, err := json.Marshal(&bl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if str := string(bs); str != `["aGVsbG8="]` {
+		t.Fatalf("invalid JSON, expected %v and received %v", `["aGVsbG8="]`, str)
+	}
+
+	var nbl bytelist.LinkedList
+	if err = json.Unmarshal(bs, &nbl); err != nil {
+		t.Fatal(err)
+	}
+
+	if val := string(nbl.Slice()[0]); val != "hello" {
+		t.Fatalf("invalid value, expected %v and received %v", "hello", val)
+	}
+}
+
+func TestDecodeJSONArray(t *testing.T) {
+	r := strings.NewReader(`["a", "b", "c"]`)
+	var seen []string
+	l, err := stringlist.DecodeJSONArray(r, stringlist.JSONArrayOpts{
+		OnElement: func(val string) error {
+			seen = append(seen, val)
+			return nil
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = testCompareStrings(l.Slice(), []string{"a", "b", "c"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = testCompareStrings(seen, []string{"a", "b", "c"}); err != nil {
+		t.Fatal(err)
+	}
+
+	r = strings.NewReader(`[1, 2, 3]`)
+	if _, err = intlist.DecodeJSONArray(r, intlist.JSONArrayOpts{Limit: 2}); err != intlist.ErrJSONArrayLimit {
+		t.Fatalf("invalid error, expected %v and received %v", intlist.ErrJSONArrayLimit, err)
+	}
+
+	errInvalid := errors.New("invalid element")
+	r = strings.NewReader(`[1, -2, 3]`)
+	_, err = intlist.DecodeJSONArray(r, intlist.JSONArrayOpts{
+		OnElement: func(val int) error {
+			if val < 0 {
+				return errInvalid
+			}
+
+			return nil
+		},
+	})
+
+	if err != errInvalid {
+		t.Fatalf("invalid error, expected %v and received %v", errInvalid, err)
+	}
+
+	r = strings.NewReader(`null`)
+	if l, err = stringlist.DecodeJSONArray(r, stringlist.JSONArrayOpts{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if l.Len() != 0 {
+		t.Fatalf("invalid length, expected %v and received %v", 0, l.Len())
+	}
+}
@@ -0,0 +1,48 @@
+package linkedlist
+
+import (
+	"fmt"
+	"testing"
+
+	bytelist "github.com/itsmontoya/linkedlist/typed/byteslice"
+)
+
+func TestGroupBy(t *testing.T) {
+	groups := New(0, 1, 2, 3, 4, 5).GroupBy(func(val GenericVal) interface{} {
+		return val.(int) % 3
+	})
+
+	if len(groups) != 3 {
+		t.Fatalf("invalid group count, expected %v and received %v", 3, len(groups))
+	}
+
+	if err := testCompareInts(testToInts(groups[2].Slice()), []int{2, 5}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKeyedSetOperations(t *testing.T) {
+	key := func(val []byte) interface{} {
+		return string(val)
+	}
+
+	var a, b bytelist.LinkedList
+	a.Append([]byte("a"), []byte("b"), []byte("a"), []byte("c"))
+	b.Append([]byte("c"), []byte("d"), []byte("b"))
+
+	if str := a.DistinctBy(key).String(); str != "[[97] [98] [99]]" {
+		t.Fatalf("invalid value, expected %v and received %v", "[[97] [98] [99]]", str)
+	}
+
+	if str := fmt.Sprintf("%s", a.UnionBy(&b, key)); str != "[a b c d]" {
+		t.Fatalf("invalid value, expected %v and received %v", "[a b c d]", str)
+	}
+
+	if str := fmt.Sprintf("%s", a.IntersectBy(&b, key)); str != "[b c]" {
+		t.Fatalf("invalid value, expected %v and received %v", "[b c]", str)
+	}
+
+	if str := fmt.Sprintf("%s", a.DifferenceBy(&b, key)); str != "[a]" {
+		t.Fatalf("invalid value, expected %v and received %v", "[a]", str)
+	}
+}
@@ -3,6 +3,7 @@ package linkedlist
 import (
 	"container/list"
 	"fmt"
+	"runtime"
 	"testing"
 
 	intlist "github.com/itsmontoya/linkedlist/typed/int"
@@ -12,6 +13,7 @@ import (
 var (
 	testFilterVal    []GenericVal
 	testFilterIntVal []int
+	testReduceVal    GenericSum
 )
 
 func TestLinkedList(t *testing.T) {
@@ -175,6 +177,45 @@ func BenchmarkListAppend(b *testing.B) {
 	b.ReportAllocs()
 }
 
+func BenchmarkMPSCQueuePush(b *testing.B) {
+	q := NewMPSCQueue()
+	for i := 0; i < b.N; i++ {
+		q.Push(i)
+	}
+
+	b.ReportAllocs()
+}
+
+func BenchmarkMPSCQueuePushParallel(b *testing.B) {
+	q := NewMPSCQueue()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			q.Push(0)
+		}
+	})
+
+	b.ReportAllocs()
+}
+
+func BenchmarkSPSCQueuePush(b *testing.B) {
+	q := NewSPSCQueue()
+	for i := 0; i < b.N; i++ {
+		q.Push(i)
+	}
+
+	b.ReportAllocs()
+}
+
+func BenchmarkMPSCQueuePushPop(b *testing.B) {
+	q := NewMPSCQueue()
+	for i := 0; i < b.N; i++ {
+		q.Push(i)
+		q.TryPop()
+	}
+
+	b.ReportAllocs()
+}
+
 func BenchmarkListFilter(b *testing.B) {
 	var l LinkedList
 	for i := 0; i < b.N; i++ {
@@ -189,6 +230,52 @@ func BenchmarkListFilter(b *testing.B) {
 	b.ReportAllocs()
 }
 
+func BenchmarkListMap(b *testing.B) {
+	l := testBenchList(b.N)
+	b.ResetTimer()
+
+	testFilterVal = l.Map(testAddOne).Slice()
+	b.ReportAllocs()
+}
+
+func BenchmarkListParallelMap(b *testing.B) {
+	l := testBenchList(b.N)
+	b.ResetTimer()
+
+	testFilterVal = l.ParallelMap(runtime.NumCPU(), testAddOne).Slice()
+	b.ReportAllocs()
+}
+
+func BenchmarkListParallelFilter(b *testing.B) {
+	l := testBenchList(b.N)
+	b.ResetTimer()
+
+	testFilterVal = l.ParallelFilter(runtime.NumCPU(), func(val GenericVal) bool {
+		return val.(int)%2 == 0
+	}).Slice()
+
+	b.ReportAllocs()
+}
+
+func BenchmarkListReduce(b *testing.B) {
+	l := testBenchList(b.N)
+	b.ResetTimer()
+
+	testReduceVal = l.Reduce(testAddInts)
+	b.ReportAllocs()
+}
+
+func BenchmarkListParallelReduce(b *testing.B) {
+	l := testBenchList(b.N)
+	b.ResetTimer()
+
+	testReduceVal = l.ParallelReduce(runtime.NumCPU(), testAddInts, func(a, b GenericSum) GenericSum {
+		return a.(int) + b.(int)
+	})
+
+	b.ReportAllocs()
+}
+
 func BenchmarkIntListAppend(b *testing.B) {
 	var l intlist.LinkedList
 	for i := 0; i < b.N; i++ {
@@ -198,6 +285,24 @@ func BenchmarkIntListAppend(b *testing.B) {
 	b.ReportAllocs()
 }
 
+func BenchmarkIntMPSCQueuePush(b *testing.B) {
+	q := intlist.NewMPSCQueue()
+	for i := 0; i < b.N; i++ {
+		q.Push(i)
+	}
+
+	b.ReportAllocs()
+}
+
+func BenchmarkIntSPSCQueuePush(b *testing.B) {
+	q := intlist.NewSPSCQueue()
+	for i := 0; i < b.N; i++ {
+		q.Push(i)
+	}
+
+	b.ReportAllocs()
+}
+
 func BenchmarkIntListFilter(b *testing.B) {
 	var l intlist.LinkedList
 	for i := 0; i < b.N; i++ {
@@ -319,3 +424,26 @@ func BenchmarkMapFilter(b *testing.B) {
 	testFilterVal = ns
 	b.ReportAllocs()
 }
+
+func testBenchList(n int) (l *LinkedList) {
+	l = &LinkedList{}
+	for i := 0; i < n; i++ {
+		l.Append(i)
+	}
+
+	return
+}
+
+func testCompareInts(received, expected []int) (err error) {
+	if len(received) != len(expected) {
+		return fmt.Errorf("invalid length, expected %d and received %d", len(expected), len(received))
+	}
+
+	for i, val := range expected {
+		if received[i] != val {
+			return fmt.Errorf("invalid value at index %d, expected %d and received %d", i, val, received[i])
+		}
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package linkedlist
+
+import (
+	"math"
+	"testing"
+
+	intlist "github.com/itsmontoya/linkedlist/typed/int"
+	int32list "github.com/itsmontoya/linkedlist/typed/int32"
+)
+
+func TestNumericAggregates(t *testing.T) {
+	l := intlist.New(7, 1, 5, 3)
+	if sum := l.Sum(); sum != 16 {
+		t.Fatalf("invalid sum, expected %v and received %v", 16, sum)
+	}
+
+	if min, max, ok := l.MinMax(); !ok || min != 1 || max != 7 {
+		t.Fatalf("invalid min and max, expected %v and %v and received %v and %v", 1, 7, min, max)
+	}
+
+	if mean, ok := l.Mean(); !ok || mean != 4 {
+		t.Fatalf("invalid mean, expected %v and received %v", 4, mean)
+	}
+
+	if median, ok := l.Median(); !ok || median != 4 {
+		t.Fatalf("invalid median, expected %v and received %v", 4, median)
+	}
+
+	if p, ok := l.Percentile(100); !ok || p != 7 {
+		t.Fatalf("invalid percentile, expected %v and received %v", 7, p)
+	}
+
+	if p, ok := l.Percentile(25); !ok || p != 2.5 {
+		t.Fatalf("invalid percentile, expected %v and received %v", 2.5, p)
+	}
+
+	if _, ok := l.Percentile(101); ok {
+		t.Fatal("expected an out of range percentile to fail")
+	}
+
+	if err := testCompareInts(l.Histogram([]int{2, 6}), []int{1, 2, 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	var empty intlist.LinkedList
+	if _, ok := empty.Max(); ok {
+		t.Fatal("expected max of an empty list to fail")
+	}
+
+	if _, ok := empty.Median(); ok {
+		t.Fatal("expected median of an empty list to fail")
+	}
+}
+
+func TestNumericSumWide(t *testing.T) {
+	l := int32list.New(math.MaxInt32, math.MaxInt32)
+	if sum := l.SumWide(); sum != 2*math.MaxInt32 {
+		t.Fatalf("invalid sum, expected %v and received %v", int64(2*math.MaxInt32), sum)
+	}
+
+	if sum := l.Sum(); sum != -2 {
+		t.Fatalf("invalid wrapped sum, expected %v and received %v", -2, sum)
+	}
+}
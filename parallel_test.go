@@ -0,0 +1,62 @@
+package linkedlist
+
+import "testing"
+
+func TestParallel(t *testing.T) {
+	var (
+		l        LinkedList
+		expected []int
+	)
+
+	for i := 0; i < 103; i++ {
+		l.Append(i)
+		if testIsEven(i) {
+			expected = append(expected, i)
+		}
+	}
+
+	for _, workers := range []int{-1, 1, 4, 7, 200} {
+		nl := l.ParallelMap(workers, testAddOne)
+		if nl.Len() != l.Len() {
+			t.Fatalf("invalid length, expected %d and received %d", l.Len(), nl.Len())
+		}
+
+		if err := testIteration(nl, 1); err != nil {
+			t.Fatal(err)
+		}
+
+		fl := l.ParallelFilter(workers, testIsEven)
+
+		if err := testCompareInts(testToInts(fl.Slice()), expected); err != nil {
+			t.Fatal(err)
+		}
+
+		sum := l.ParallelReduce(workers, testAddInts, func(a, b GenericSum) GenericSum {
+			return a.(int) + b.(int)
+		})
+
+		if sum != l.Reduce(testAddInts) {
+			t.Fatalf("invalid value, expected %v and received %v", l.Reduce(testAddInts), sum)
+		}
+	}
+
+	var empty LinkedList
+	if nl := empty.ParallelMap(4, testAddOne); nl.Len() != 0 {
+		t.Fatalf("invalid length, expected %d and received %d", 0, nl.Len())
+	}
+}
+
+func TestParallelMapCursor(t *testing.T) {
+	l := New(0, 1, 2, 3, 4)
+	nl := l.ParallelMap(2, testAddOne)
+
+	// Nodes of the joined segments belong to the resulting list
+	c := nl.Cursor(nl.tail)
+	if val, ok := c.Remove(); !ok || val != 5 {
+		t.Fatalf("invalid value, expected %v and received %v", 5, val)
+	}
+
+	if err := testCompareInts(testToInts(nl.Slice()), []int{1, 2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,81 @@
+package linkedlist
+
+import "testing"
+
+func TestPersistentList(t *testing.T) {
+	var empty PersistentList
+	a := empty.Append(2, 3)
+	b := a.Prepend(1, 0)
+	c := b.Append(4)
+
+	if empty.Len() != 0 {
+		t.Fatalf("invalid length, expected %v and received %v", 0, empty.Len())
+	}
+
+	if err := testCompareInts(testToInts(a.Slice()), []int{2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testToInts(b.Slice()), []int{0, 1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testToInts(c.Slice()), []int{0, 1, 2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove a node from both the front and back chains
+	d := c
+	c.ForEach(func(n *PersistentNode, val GenericVal) bool {
+		if val.(int)%2 == 1 {
+			d = d.Remove(n)
+		}
+
+		return false
+	})
+
+	if err := testCompareInts(testToInts(d.Slice()), []int{0, 2, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure the previous version was not modified
+	if err := testCompareInts(testToInts(c.Slice()), []int{0, 1, 2, 3, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Removing a node which doesn't belong to the list should return the list as-is
+	if e := d.Remove(&PersistentNode{}); e != d {
+		t.Fatal("expected the list to be returned as-is")
+	}
+}
+
+func TestPersistentMapReduce(t *testing.T) {
+	var l PersistentList
+	nl := l.Append(0, 1, 2).Prepend(-1).Map(testAddOne)
+	if err := testCompareInts(testToInts(nl.Slice()), []int{0, 1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if val := nl.Reduce(testAddInts); val != 6 {
+		t.Fatalf("expected %v and received %v", 6, val)
+	}
+
+	// Mapped lists are stored in the front chain, so iterating does not allocate
+	if allocs := testing.AllocsPerRun(10, func() {
+		nl.ForEach(func(_ *PersistentNode, _ GenericVal) bool {
+			return false
+		})
+	}); allocs != 0 {
+		t.Fatalf("invalid allocations, expected %v and received %v", 0, allocs)
+	}
+}
+
+func BenchmarkPersistentListAppend(b *testing.B) {
+	var l PersistentList
+	nl := &l
+	for i := 0; i < b.N; i++ {
+		nl = nl.Append(i)
+	}
+
+	b.ReportAllocs()
+}
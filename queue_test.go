@@ -0,0 +1,100 @@
+package linkedlist
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMPSCQueue(t *testing.T) {
+	const (
+		producers = 4
+		perWorker = 1000
+	)
+
+	var wg sync.WaitGroup
+	q := NewMPSCQueue()
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				q.Push(p*perWorker + i)
+			}
+		}(p)
+	}
+
+	// Last value popped for each producer, used to ensure per-producer ordering
+	last := make([]int, producers)
+	for i := range last {
+		last[i] = -1
+	}
+
+	for popped := 0; popped < producers*perWorker; {
+		val, ok := q.TryPop()
+		if !ok {
+			continue
+		}
+
+		v := val.(int)
+		p := v / perWorker
+		if v <= last[p] {
+			t.Fatalf("invalid order for producer %d, received %d after %d", p, v, last[p])
+		}
+
+		last[p] = v
+		popped++
+	}
+
+	wg.Wait()
+	if _, ok := q.TryPop(); ok {
+		t.Fatal("expected pop on an empty queue to fail")
+	}
+}
+
+func TestSPSCQueue(t *testing.T) {
+	const count = 10000
+	q := NewSPSCQueue()
+	go func() {
+		for i := 0; i < count; i++ {
+			q.Push(i)
+		}
+	}()
+
+	for i := 0; i < count; {
+		val, ok := q.TryPop()
+		if !ok {
+			continue
+		}
+
+		if val.(int) != i {
+			t.Fatalf("invalid value, expected %d and received %v", i, val)
+		}
+
+		i++
+	}
+
+	if _, ok := q.TryPop(); ok {
+		t.Fatal("expected pop on an empty queue to fail")
+	}
+}
+
+func TestQueueZeroValue(t *testing.T) {
+	var (
+		mq MPSCQueue
+		sq SPSCQueue
+	)
+
+	if _, ok := mq.TryPop(); ok {
+		t.Fatal("expected empty queue to return no value")
+	}
+
+	mq.Push(1)
+	sq.Push(1)
+	if val, ok := mq.TryPop(); !ok || val != 1 {
+		t.Fatalf("invalid value, expected %v and received %v", 1, val)
+	}
+
+	if val, ok := sq.TryPop(); !ok || val != 1 {
+		t.Fatalf("invalid value, expected %v and received %v", 1, val)
+	}
+}
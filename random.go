@@ -0,0 +1,90 @@
+package linkedlist
+
+//go:generate genny -in=$GOFILE -out=typed/int/$GOFILE gen "GenericVal=int GenericSum=int"
+//go:generate genny -in=$GOFILE -out=typed/int32/$GOFILE gen "GenericVal=int32 GenericSum=int32"
+//go:generate genny -in=$GOFILE -out=typed/int64/$GOFILE gen "GenericVal=int64 GenericSum=int64"
+//go:generate genny -in=$GOFILE -out=typed/string/$GOFILE gen "GenericVal=string GenericSum=string"
+//go:generate genny -in=$GOFILE -out=typed/byteslice/$GOFILE gen "GenericVal=[]byte GenericSum=[]byte"
+
+import "math/rand"
+
+// Shuffle will randomly reorder the list in place, the nodes are relinked rather than copied
+func (l *LinkedList) Shuffle(rng *rand.Rand) {
+	nodes := make([]*Node, 0, l.len)
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, _ GenericVal) bool {
+		nodes = append(nodes, n)
+		return false
+	})
+
+	rng.Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+
+	// Relink the nodes in their shuffled order
+	l.head, l.tail, l.len = nil, nil, 0
+	for _, n := range nodes {
+		l.appendNode(n)
+	}
+}
+
+// Sample will return a list of k randomly selected values using reservoir sampling in a single pass
+// Note: When the list has k or fewer values, every value is returned
+func (l *LinkedList) Sample(k int, rng *rand.Rand) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	if k < 1 {
+		return
+	}
+
+	// The reservoir will never hold more values than the list, so the capacity is bounded by the list length
+	size := k
+	if int(l.len) < size {
+		size = int(l.len)
+	}
+
+	reservoir := make([]GenericVal, 0, size)
+	// Number of seen values
+	var seen int
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val GenericVal) bool {
+		if seen++; len(reservoir) < k {
+			// Reservoir is not full, add the value
+			reservoir = append(reservoir, val)
+			return false
+		}
+
+		if i := rng.Intn(seen); i < k {
+			// Replace a reservoir value
+			reservoir[i] = val
+		}
+
+		return false
+	})
+
+	nl.Append(reservoir...)
+	return
+}
+
+// RandomNode will return a randomly selected node, nil is returned when the list is empty
+func (l *LinkedList) RandomNode(rng *rand.Rand) (n *Node) {
+	if l.len == 0 {
+		return
+	}
+
+	i := int32(rng.Intn(int(l.len)))
+	if i < l.len/2 {
+		// Node is within the first half, walk forward from the head
+		for n = l.head; i > 0; i-- {
+			n = n.next
+		}
+
+		return
+	}
+
+	// Node is within the second half, walk backward from the tail
+	for n = l.tail; i < l.len-1; i++ {
+		n = n.prev
+	}
+
+	return
+}
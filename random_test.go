@@ -0,0 +1,93 @@
+package linkedlist
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestShuffle(t *testing.T) {
+	l := New(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	head := l.head
+	l.Shuffle(rand.New(rand.NewSource(1)))
+
+	vals := testToInts(l.Slice())
+	if err := testCompareInts(vals, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}); err == nil {
+		t.Fatal("expected shuffled list to be reordered")
+	}
+
+	sort.Ints(vals)
+	if err := testCompareInts(vals, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testIterationRev(l); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure nodes were relinked rather than copied
+	var found bool
+	l.ForEach(nil, func(n *Node, _ GenericVal) bool {
+		found = n == head
+		return found
+	})
+
+	if !found {
+		t.Fatal("expected original head node to remain within the list")
+	}
+}
+
+func TestSample(t *testing.T) {
+	var l LinkedList
+	for i := 0; i < 100; i++ {
+		l.Append(i)
+	}
+
+	sample := l.Sample(10, rand.New(rand.NewSource(1)))
+	if sample.Len() != 10 {
+		t.Fatalf("invalid length, expected %d and received %d", 10, sample.Len())
+	}
+
+	seen := make(map[int]bool)
+	sample.ForEach(nil, func(_ *Node, val GenericVal) bool {
+		if seen[val.(int)] {
+			t.Fatalf("duplicate value %v within sample", val)
+		}
+
+		seen[val.(int)] = true
+		return false
+	})
+
+	// Same seed produces the same sample
+	again := l.Sample(10, rand.New(rand.NewSource(1)))
+	if err := testCompareInts(testToInts(again.Slice()), testToInts(sample.Slice())); err != nil {
+		t.Fatal(err)
+	}
+
+	if small := New(1, 2).Sample(5, rand.New(rand.NewSource(1))); small.Len() != 2 {
+		t.Fatalf("invalid length, expected %d and received %d", 2, small.Len())
+	}
+
+	if all := New(1, 2).Sample(math.MaxInt32, rand.New(rand.NewSource(1))); all.Len() != 2 {
+		t.Fatalf("invalid length, expected %d and received %d", 2, all.Len())
+	}
+}
+
+func TestRandomNode(t *testing.T) {
+	var empty LinkedList
+	if n := empty.RandomNode(rand.New(rand.NewSource(1))); n != nil {
+		t.Fatal("expected nil node for an empty list")
+	}
+
+	l := New(0, 1, 2, 3, 4)
+	rng := rand.New(rand.NewSource(1))
+	seen := make(map[int]bool)
+	for i := 0; i < 100; i++ {
+		seen[l.Val(l.RandomNode(rng)).(int)] = true
+	}
+
+	if len(seen) != 5 {
+		t.Fatalf("invalid value, expected %v and received %v", 5, len(seen))
+	}
+}
@@ -0,0 +1,130 @@
+package linkedlist
+
+import "testing"
+
+func TestRemoveIf(t *testing.T) {
+	l := New(0, 1, 2, 3, 4, 5)
+	if removed := l.RemoveIf(testIsEven); removed != 3 {
+		t.Fatalf("invalid value, expected %v and received %v", 3, removed)
+	}
+
+	if err := testCompareInts(testToInts(l.Slice()), []int{1, 3, 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	if l.Len() != 3 {
+		t.Fatalf("invalid length, expected %d and received %d", 3, l.Len())
+	}
+}
+
+func TestExtract(t *testing.T) {
+	l := New(0, 1, 2, 3, 4, 5)
+	second := l.head.next.next
+
+	nl := l.Extract(testIsEven)
+	if err := testCompareInts(testToInts(nl.Slice()), []int{0, 2, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testToInts(l.Slice()), []int{1, 3, 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	if nl.head.next != second {
+		t.Fatal("expected extracted nodes to be moved rather than copied")
+	}
+
+	if l.Len() != 3 || nl.Len() != 3 {
+		t.Fatalf("invalid lengths, expected %d and %d and received %d and %d", 3, 3, l.Len(), nl.Len())
+	}
+
+	if err := testIterationRev(nl); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	l := New(0, 1, 2, 3, 4, 5)
+	if removed := l.RemoveRange(l.head.next, l.tail.prev); removed != 4 {
+		t.Fatalf("invalid value, expected %v and received %v", 4, removed)
+	}
+
+	if err := testCompareInts(testToInts(l.Slice()), []int{0, 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	if removed := l.RemoveRange(nil, nil); removed != 2 {
+		t.Fatalf("invalid value, expected %v and received %v", 2, removed)
+	}
+
+	if l.Len() != 0 || l.head != nil || l.tail != nil {
+		t.Fatal("expected list to be empty")
+	}
+
+	// Ranges starting or ending on a removed node are ignored
+	l = New(1, 2, 3, 4, 5)
+	removed := l.head.next
+	l.Remove(removed)
+	if n := l.RemoveRange(removed, l.tail.prev); n != 0 {
+		t.Fatalf("invalid value, expected %v and received %v", 0, n)
+	}
+
+	if n := l.RemoveRange(l.head, removed); n != 0 {
+		t.Fatalf("invalid value, expected %v and received %v", 0, n)
+	}
+
+	if err := testCompareInts(testToInts(l.Slice()), []int{1, 3, 4, 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	if l.Len() != 4 {
+		t.Fatalf("invalid length, expected %d and received %d", 4, l.Len())
+	}
+}
+
+func testIterationRev(l *LinkedList) (err error) {
+	vals := testToInts(l.Slice())
+	var rev []int
+	l.ForEachRev(nil, func(_ *Node, val GenericVal) bool {
+		rev = append([]int{val.(int)}, rev...)
+		return false
+	})
+
+	return testCompareInts(rev, vals)
+}
+
+func TestExtractCursor(t *testing.T) {
+	l := New(0, 1, 2, 3, 4, 5)
+	c := l.Cursor(l.head.next.next)
+	it := l.Iterator(l.head.next.next)
+
+	nl := l.Extract(testIsEven)
+	if _, ok := c.Value(); ok {
+		t.Fatal("expected cursor on an extracted node to move before the head")
+	}
+
+	if _, ok := it.Peek(); ok {
+		t.Fatal("expected iterator on an extracted node to be exhausted")
+	}
+
+	// Removing through the cursor must not affect either list
+	if _, ok := c.Remove(); ok {
+		t.Fatal("expected cursor before the head to remove nothing")
+	}
+
+	if c.Next(); c.Node() != l.head {
+		t.Fatal("expected cursor to move onto the head")
+	}
+
+	if err := testCompareInts(testToInts(l.Slice()), []int{1, 3, 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testToInts(nl.Slice()), []int{0, 2, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	if l.Len() != 3 || nl.Len() != 3 {
+		t.Fatalf("invalid lengths, expected %d and %d and received %d and %d", 3, 3, l.Len(), nl.Len())
+	}
+}
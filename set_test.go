@@ -0,0 +1,33 @@
+package linkedlist
+
+import (
+	"testing"
+
+	stringlist "github.com/itsmontoya/linkedlist/typed/string"
+)
+
+func TestSetOperations(t *testing.T) {
+	a := stringlist.New("a", "b", "a", "c")
+	b := stringlist.New("c", "d", "b", "d")
+
+	if str := a.Distinct().Join(","); str != "a,b,c" {
+		t.Fatalf("invalid value, expected %v and received %v", "a,b,c", str)
+	}
+
+	if str := a.Union(b).Join(","); str != "a,b,c,d" {
+		t.Fatalf("invalid value, expected %v and received %v", "a,b,c,d", str)
+	}
+
+	if str := a.Intersect(b).Join(","); str != "b,c" {
+		t.Fatalf("invalid value, expected %v and received %v", "b,c", str)
+	}
+
+	if str := a.Difference(b).Join(","); str != "a" {
+		t.Fatalf("invalid value, expected %v and received %v", "a", str)
+	}
+
+	// Ensure the source lists were not modified
+	if a.Len() != 4 || b.Len() != 4 {
+		t.Fatalf("invalid lengths, expected %v and received %v and %v", 4, a.Len(), b.Len())
+	}
+}
@@ -0,0 +1,95 @@
+package linkedlist
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSinglyLinkedList(t *testing.T) {
+	var l SinglyLinkedList
+	l.Append(2, 3, 4)
+	l.PushFront(1, 0)
+	if l.Len() != 5 {
+		t.Fatalf("invalid length, expected %v and received %v", 5, l.Len())
+	}
+
+	if err := testSinglyIteration(&l, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		val, ok := l.PopFront()
+		if !ok {
+			t.Fatalf("expected pop to succeed at index %d", i)
+		}
+
+		if val.(int) != i {
+			t.Fatalf("invalid value, expected %d and received %v", i, val)
+		}
+	}
+
+	if _, ok := l.PopFront(); ok {
+		t.Fatal("expected pop on an empty list to fail")
+	}
+
+	// Ensure head and tail were reset by appending to the emptied list
+	l.Append(7)
+	if val := l.Slice(); len(val) != 1 || val[0].(int) != 7 {
+		t.Fatalf("invalid slice, expected %v and received %v", []int{7}, val)
+	}
+}
+
+func TestSinglyMapFilterReduce(t *testing.T) {
+	var l SinglyLinkedList
+	l.Append(0, 1, 2, 3, 4, 5, 6)
+
+	nl := l.Map(testAddOne).Filter(testIsEven)
+	if val := nl.Reduce(testAddInts); val != 12 {
+		t.Fatalf("expected %v and received %v", 12, val)
+	}
+
+	// Ensure the filtered list tail is valid after in-place removals
+	nl.Append(8)
+	if val := nl.Reduce(testAddInts); val != 20 {
+		t.Fatalf("expected %v and received %v", 20, val)
+	}
+
+	// Ensure the source list was not modified
+	if l.Len() != 7 {
+		t.Fatalf("invalid length, expected %v and received %v", 7, l.Len())
+	}
+}
+
+func testSinglyIteration(l *SinglyLinkedList, start int) (err error) {
+	cnt := start
+
+	l.ForEach(nil, func(_ *SinglyNode, val GenericVal) bool {
+		if val.(int) != cnt {
+			err = fmt.Errorf("invalid value, expected %d and received %d", cnt, val)
+			return true
+		}
+
+		cnt++
+		return false
+	})
+
+	return
+}
+
+func BenchmarkSinglyListAppend(b *testing.B) {
+	var l SinglyLinkedList
+	for i := 0; i < b.N; i++ {
+		l.Append(i)
+	}
+
+	b.ReportAllocs()
+}
+
+func BenchmarkSinglyListPushFront(b *testing.B) {
+	var l SinglyLinkedList
+	for i := 0; i < b.N; i++ {
+		l.PushFront(i)
+	}
+
+	b.ReportAllocs()
+}
@@ -0,0 +1,227 @@
+package linkedlist
+
+//go:generate genny -in=$GOFILE -out=typed/int/$GOFILE gen "GenericVal=int GenericSum=int"
+//go:generate genny -in=$GOFILE -out=typed/int32/$GOFILE gen "GenericVal=int32 GenericSum=int32"
+//go:generate genny -in=$GOFILE -out=typed/int64/$GOFILE gen "GenericVal=int64 GenericSum=int64"
+//go:generate genny -in=$GOFILE -out=typed/string/$GOFILE gen "GenericVal=string GenericSum=string"
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// SQLJSON encodes lists as a JSON array
+	SQLJSON SQLEncoding = iota
+	// SQLPostgresArray encodes lists as a Postgres array literal
+	SQLPostgresArray
+)
+
+// ErrInvalidPostgresArray is returned when scanning a malformed or unsupported Postgres array literal
+var ErrInvalidPostgresArray = errors.New("invalid Postgres array literal")
+
+// Value implements driver.Valuer, the list is encoded as a JSON array
+func (l *LinkedList) Value() (v driver.Value, err error) {
+	return SQLList{List: l, Encoding: SQLJSON}.Value()
+}
+
+// Scan implements sql.Scanner, both JSON arrays and Postgres array literals are accepted
+// Note: Scanned values replace the existing values of the list, a NULL column will empty the list
+func (l *LinkedList) Scan(src interface{}) (err error) {
+	return SQLList{List: l}.Scan(src)
+}
+
+// SQLList is a list with a selected encoding, it implements driver.Valuer and sql.Scanner
+type SQLList struct {
+	List     *LinkedList
+	Encoding SQLEncoding
+}
+
+// Value implements driver.Valuer, the list is encoded using the selected encoding
+func (s SQLList) Value() (v driver.Value, err error) {
+	switch s.Encoding {
+	case SQLJSON:
+		var bs []byte
+		if bs, err = s.List.MarshalJSON(); err != nil {
+			return
+		}
+
+		return string(bs), nil
+	case SQLPostgresArray:
+		return s.List.postgresArray(), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported SQL encoding %d", s.Encoding)
+	}
+}
+
+// Scan implements sql.Scanner, both JSON arrays and Postgres array literals are accepted regardless of the selected encoding
+// Note: Scanned values replace the existing values of the list, a NULL column will empty the list. The list
+// is left unchanged when an error is returned
+func (s SQLList) Scan(src interface{}) (err error) {
+	// Values are scanned into a temporary list, which replaces the list once the column has been parsed
+	var (
+		nl LinkedList
+		bs []byte
+	)
+
+	switch v := src.(type) {
+	case nil:
+		// Column is NULL, empty the list
+		s.List.replace(&nl)
+		return
+	case []byte:
+		bs = v
+	case string:
+		bs = []byte(v)
+
+	default:
+		return fmt.Errorf("unsupported SQL source type %T", src)
+	}
+
+	if bs = bytes.TrimSpace(bs); len(bs) > 0 && bs[0] == '{' {
+		// Source is a Postgres array literal
+		err = nl.scanPostgresArray(string(bs))
+	} else {
+		err = nl.decodeJSONArray(json.NewDecoder(bytes.NewReader(bs)), JSONArrayOpts{})
+	}
+
+	if err != nil {
+		return
+	}
+
+	s.List.replace(&nl)
+	return
+}
+
+// postgresArray will return the list as a Postgres array literal
+func (l *LinkedList) postgresArray() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, val GenericVal) bool {
+		if n != l.head {
+			// This is not the first item, write a separator
+			sb.WriteByte(',')
+		}
+
+		str, quote := formatSQLVal(val)
+		if !quote {
+			sb.WriteString(str)
+			return false
+		}
+
+		sb.WriteByte('"')
+		// Iterate through each byte, escaping quotes and backslashes
+		for i := 0; i < len(str); i++ {
+			if str[i] == '"' || str[i] == '\\' {
+				sb.WriteByte('\\')
+			}
+
+			sb.WriteByte(str[i])
+		}
+
+		sb.WriteByte('"')
+		return false
+	})
+
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// scanPostgresArray will parse a one-dimensional Postgres array literal, parsed values are appended to the list
+func (l *LinkedList) scanPostgresArray(str string) (err error) {
+	if len(str) < 2 || str[0] != '{' || str[len(str)-1] != '}' {
+		return ErrInvalidPostgresArray
+	}
+
+	// Trim the surrounding braces
+	if str = str[1 : len(str)-1]; len(str) == 0 {
+		// Array is empty, return early
+		return
+	}
+
+	// Parsed values are collected so the list is unchanged when the literal is invalid
+	var vals []GenericVal
+	for i := 0; ; i++ {
+		var (
+			elem   strings.Builder
+			quoted bool
+		)
+
+		// Skip whitespace preceding the element
+		for i < len(str) && str[i] == ' ' {
+			i++
+		}
+
+		if i < len(str) && str[i] == '"' {
+			// Element is quoted, read until the closing quote
+			quoted = true
+			for i++; i < len(str) && str[i] != '"'; i++ {
+				if str[i] == '\\' {
+					// Skip the escape character
+					i++
+				}
+
+				if i < len(str) {
+					elem.WriteByte(str[i])
+				}
+			}
+
+			if i >= len(str) {
+				// Closing quote was not found
+				return ErrInvalidPostgresArray
+			}
+
+			// Move past the closing quote
+			i++
+			// Skip whitespace following the element
+			for i < len(str) && str[i] == ' ' {
+				i++
+			}
+		} else {
+			// Element is unquoted, read until the next separator
+			for ; i < len(str) && str[i] != ','; i++ {
+				if str[i] == '{' || str[i] == '"' {
+					// Nested arrays and unbalanced quotes are not supported
+					return ErrInvalidPostgresArray
+				}
+
+				elem.WriteByte(str[i])
+			}
+		}
+
+		raw := elem.String()
+		if !quoted && strings.EqualFold(strings.TrimSpace(raw), "NULL") {
+			// NULL elements cannot be represented within the list
+			return ErrInvalidPostgresArray
+		}
+
+		var val GenericVal
+		if val, err = parseSQLVal(raw, quoted); err != nil {
+			return
+		}
+
+		vals = append(vals, val)
+
+		if i >= len(str) {
+			// We have reached the end of the literal
+			break
+		}
+
+		if str[i] != ',' {
+			// Elements must be separated by a comma
+			return ErrInvalidPostgresArray
+		}
+	}
+
+	l.Append(vals...)
+	return
+}
+
+// SQLEncoding represents the encoding used to store a list within a SQL column
+type SQLEncoding uint8
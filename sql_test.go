@@ -0,0 +1,215 @@
+package linkedlist
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	int64list "github.com/itsmontoya/linkedlist/typed/int64"
+	stringlist "github.com/itsmontoya/linkedlist/typed/string"
+)
+
+func init() {
+	sql.Register("linkedlist-test", &testDriver{})
+}
+
+func TestSQLValueScan(t *testing.T) {
+	db, err := sql.Open("linkedlist-test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	tags := stringlist.New("a", `b "c"`, `d\e`, "f,g")
+	if _, err = db.Exec("INSERT", "json", tags); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Exec("INSERT", "pg", stringlist.SQLList{List: tags, Encoding: stringlist.SQLPostgresArray}); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := int64list.New(1, -2, 3)
+	if _, err = db.Exec("INSERT", "ids", int64list.SQLList{List: ids, Encoding: int64list.SQLPostgresArray}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"json", "pg"} {
+		var scanned stringlist.LinkedList
+		if err = db.QueryRow("SELECT", key).Scan(&scanned); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = testCompareStrings(scanned.Slice(), tags.Slice()); err != nil {
+			t.Fatalf("%s: %v", key, err)
+		}
+	}
+
+	var scannedIDs int64list.LinkedList
+	if err = db.QueryRow("SELECT", "ids").Scan(&scannedIDs); err != nil {
+		t.Fatal(err)
+	}
+
+	if str := scannedIDs.String(); str != "[1 -2 3]" {
+		t.Fatalf("invalid value, expected %v and received %v", "[1 -2 3]", str)
+	}
+}
+
+func TestSQLPostgresArray(t *testing.T) {
+	v, err := stringlist.SQLList{List: stringlist.New("a", `b "c"`), Encoding: stringlist.SQLPostgresArray}.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v != `{"a","b \"c\""}` {
+		t.Fatalf("invalid value, expected %v and received %v", `{"a","b \"c\""}`, v)
+	}
+
+	var l stringlist.LinkedList
+	if err = l.Scan(`{foo, "bar baz",qux}`); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = testCompareStrings(l.Slice(), []string{"foo", "bar baz", "qux"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, invalid := range []string{`{a,NULL}`, `{{1,2},{3,4}}`, `{"a}`, `{"a"b}`} {
+		var nl stringlist.LinkedList
+		if err = nl.Scan(invalid); err != stringlist.ErrInvalidPostgresArray {
+			t.Fatalf("invalid error for %s, expected %v and received %v", invalid, stringlist.ErrInvalidPostgresArray, err)
+		}
+
+		if nl.Len() != 0 {
+			t.Fatalf("invalid length for %s, expected %v and received %v", invalid, 0, nl.Len())
+		}
+	}
+
+	var empty int64list.LinkedList
+	if err = empty.Scan([]byte("{}")); err != nil || empty.Len() != 0 {
+		t.Fatalf("invalid empty scan, received %v (%v)", empty.Slice(), err)
+	}
+
+	if err = empty.Scan(nil); err != nil || empty.Len() != 0 {
+		t.Fatalf("invalid NULL scan, received %v (%v)", empty.Slice(), err)
+	}
+}
+
+func TestSQLScanReplace(t *testing.T) {
+	// Scanning into a reused list replaces its values, as a rows.Next loop would
+	var l int64list.LinkedList
+	for _, src := range []interface{}{`[1,2]`, []byte(`{3}`)} {
+		if err := l.Scan(src); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if vals := l.Slice(); len(vals) != 1 || vals[0] != 3 {
+		t.Fatalf("invalid values, expected %v and received %v", []int64{3}, vals)
+	}
+
+	// A partially valid JSON column leaves the list unchanged
+	if err := l.Scan(`[4,"five"]`); err == nil {
+		t.Fatal("expected an error when scanning an invalid JSON column")
+	}
+
+	if vals := l.Slice(); len(vals) != 1 || vals[0] != 3 {
+		t.Fatalf("invalid values, expected %v and received %v", []int64{3}, vals)
+	}
+
+	if err := l.Scan(nil); err != nil || l.Len() != 0 {
+		t.Fatalf("invalid NULL scan, received %v (%v)", l.Slice(), err)
+	}
+}
+
+// testDriver is an in-memory database/sql driver which stores a single value per key
+// Statements are either "INSERT" with a key and value, or "SELECT" with a key
+type testDriver struct {
+	mux sync.Mutex
+	m   map[string]driver.Value
+}
+
+func (d *testDriver) Open(_ string) (driver.Conn, error) {
+	return &testConn{d: d}, nil
+}
+
+type testConn struct {
+	d *testDriver
+}
+
+func (c *testConn) Prepare(query string) (driver.Stmt, error) {
+	return &testStmt{d: c.d, query: query}, nil
+}
+
+func (c *testConn) Close() error {
+	return nil
+}
+
+func (c *testConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type testStmt struct {
+	d     *testDriver
+	query string
+}
+
+func (s *testStmt) Close() error {
+	return nil
+}
+
+func (s *testStmt) NumInput() int {
+	if s.query == "INSERT" {
+		return 2
+	}
+
+	return 1
+}
+
+func (s *testStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mux.Lock()
+	defer s.d.mux.Unlock()
+	if s.d.m == nil {
+		s.d.m = make(map[string]driver.Value)
+	}
+
+	s.d.m[args[0].(string)] = args[1]
+	return driver.RowsAffected(1), nil
+}
+
+func (s *testStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.d.mux.Lock()
+	defer s.d.mux.Unlock()
+	v, ok := s.d.m[args[0].(string)]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return &testRows{v: v}, nil
+}
+
+type testRows struct {
+	v    driver.Value
+	done bool
+}
+
+func (r *testRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *testRows) Close() error {
+	return nil
+}
+
+func (r *testRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	dest[0] = r.v
+	r.done = true
+	return nil
+}
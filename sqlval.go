@@ -0,0 +1,29 @@
+package linkedlist
+
+import (
+	"fmt"
+	"strings"
+)
+
+// formatSQLVal will format a value as a Postgres array element
+// Note: The generic list quotes all values which are not numbers or booleans
+func formatSQLVal(val GenericVal) (str string, quote bool) {
+	switch val.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, bool:
+		return fmt.Sprint(val), false
+
+	default:
+		return fmt.Sprint(val), true
+	}
+}
+
+// parseSQLVal will parse a value from a Postgres array element
+// Note: The generic list cannot infer element types, so each value is parsed as a string
+func parseSQLVal(raw string, quoted bool) (val GenericVal, err error) {
+	if !quoted {
+		// Unquoted elements have their surrounding whitespace ignored
+		return strings.TrimSpace(raw), nil
+	}
+
+	return raw, nil
+}
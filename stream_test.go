@@ -0,0 +1,82 @@
+package linkedlist
+
+import "testing"
+
+func TestStream(t *testing.T) {
+	var l LinkedList
+	l.Append(0, 1, 2, 3, 4, 5, 6)
+
+	if val := l.Stream().Map(testAddOne).Filter(testIsEven).Reduce(testAddInts); val != 12 {
+		t.Fatalf("expected %v and received %v", 12, val)
+	}
+
+	// Ensure stages are lazy and stop once the stream has ended
+	var mapped int
+	s := l.Stream().Skip(1).Map(func(val GenericVal) GenericVal {
+		mapped++
+		return val.(int) * 10
+	}).Take(2)
+
+	if err := testCompareInts(testToInts(s.Slice()), []int{10, 20}); err != nil {
+		t.Fatal(err)
+	}
+
+	if mapped != 2 {
+		t.Fatalf("invalid map count, expected %v and received %v", 2, mapped)
+	}
+
+	// Ensure Take(0) does not pull any values
+	mapped = 0
+	if vals := s.Take(0).Slice(); len(vals) != 0 || mapped != 0 {
+		t.Fatalf("invalid Take(0), received %v after %v map calls", vals, mapped)
+	}
+
+	// Ensure stateful stages are reset between runs
+	nl := s.Collect()
+	if err := testCompareInts(testToInts(nl.Slice()), []int{10, 20}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure the source list was not modified
+	if err := testCompareInts(testToInts(l.Slice()), []int{0, 1, 2, 3, 4, 5, 6}); err != nil {
+		t.Fatal(err)
+	}
+
+	var cnt int
+	ended := l.Stream().ForEach(func(val GenericVal) bool {
+		cnt++
+		return val.(int) == 2
+	})
+
+	if !ended || cnt != 3 {
+		t.Fatalf("invalid iteration, expected %v values and received %v", 3, cnt)
+	}
+}
+
+func BenchmarkListMapFilterReduce(b *testing.B) {
+	var l LinkedList
+	for i := 0; i < 1024; i++ {
+		l.Append(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.Map(testAddOne).Filter(testIsEven).Reduce(testAddInts)
+	}
+
+	b.ReportAllocs()
+}
+
+func BenchmarkStreamMapFilterReduce(b *testing.B) {
+	var l LinkedList
+	for i := 0; i < 1024; i++ {
+		l.Append(i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		l.Stream().Map(testAddOne).Filter(testIsEven).Reduce(testAddInts)
+	}
+
+	b.ReportAllocs()
+}
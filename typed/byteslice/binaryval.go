@@ -0,0 +1,26 @@
+package linkedlist
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// writeBinaryVal will write a value as varint length prefixed bytes
+func writeBinaryVal(w io.Writer, buf []byte, val []byte) (err error) {
+	if _, err = w.Write(buf[:binary.PutUvarint(buf, uint64(len(val)))]); err != nil {
+		return
+	}
+
+	_, err = w.Write(val)
+	return
+}
+
+// readBinaryVal will read a value from varint length prefixed bytes
+func readBinaryVal(r binaryReader) (val []byte, err error) {
+	var length uint64
+	if length, err = binary.ReadUvarint(r); err != nil {
+		return
+	}
+
+	return readBinaryBytes(r, length)
+}
@@ -0,0 +1,117 @@
+package linkedlist
+
+import (
+	"io"
+	"net"
+)
+
+// bufferReadSize is the size of each read made by Buffer.ReadFrom
+const bufferReadSize = 32 * 1024
+
+// Buffers will return the chunks of the list as net.Buffers, the chunks are not copied
+func (l *LinkedList) Buffers() (bufs net.Buffers) {
+	bufs = make(net.Buffers, 0, l.len)
+	l.ForEach(nil, func(_ *Node, val []byte) bool {
+		bufs = append(bufs, val)
+		return false
+	})
+
+	return
+}
+
+// Buffer is a byte stream backed by a linked list of byte chunks, it implements io.Reader, io.Writer,
+// io.ReaderFrom and io.WriterTo
+// Note: The list is not embedded, so the binary list methods (eg. ReadFrom) do not apply to the byte stream
+type Buffer struct {
+	list LinkedList
+	// Number of unread bytes
+	size int
+}
+
+// Write will append a copy of the provided bytes as a new chunk
+func (b *Buffer) Write(p []byte) (n int, err error) {
+	if len(p) == 0 {
+		// Nothing to write, return early
+		return
+	}
+
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+	b.list.append(chunk)
+	b.size += len(p)
+	return len(p), nil
+}
+
+// ReadFrom will read from a reader until io.EOF, each read is appended as a new chunk
+func (b *Buffer) ReadFrom(r io.Reader) (n int64, err error) {
+	// Scratch buffer, each read is copied into a chunk of the exact read size
+	buf := make([]byte, bufferReadSize)
+	for {
+		var read int
+		read, err = r.Read(buf)
+		b.Write(buf[:read])
+		n += int64(read)
+
+		if err == io.EOF {
+			// Reader has been drained, this is not an error for io.ReaderFrom
+			return n, nil
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Read will read bytes from the head of the buffer, read chunks are removed
+func (b *Buffer) Read(p []byte) (n int, err error) {
+	if b.list.head == nil {
+		// Buffer is empty
+		return 0, io.EOF
+	}
+
+	// Iterate until we have filled p or run out of chunks
+	for b.list.head != nil && n < len(p) {
+		copied := copy(p[n:], b.list.head.val)
+		n += copied
+		b.discard(int64(copied))
+	}
+
+	return
+}
+
+// WriteTo will write the buffer to a writer using vectored writes when supported, written chunks are removed
+func (b *Buffer) WriteTo(w io.Writer) (n int64, err error) {
+	bufs := b.list.Buffers()
+	n, err = bufs.WriteTo(w)
+	b.discard(n)
+	return
+}
+
+// Buffers will return the unread chunks of the buffer as net.Buffers, the chunks are not copied
+func (b *Buffer) Buffers() (bufs net.Buffers) {
+	return b.list.Buffers()
+}
+
+// Len will return the number of unread bytes
+func (b *Buffer) Len() (n int) {
+	return b.size
+}
+
+// discard will remove n bytes from the head of the buffer
+// Note: Empty chunks at the head of the buffer are removed as well
+func (b *Buffer) discard(n int64) {
+	b.size -= int(n)
+	// Iterate until we have discarded n bytes or run out of chunks
+	for b.list.head != nil {
+		chunkLen := int64(len(b.list.head.val))
+		if chunkLen > n {
+			// Chunk is partially consumed, trim the consumed bytes
+			b.list.head.val = b.list.head.val[n:]
+			return
+		}
+
+		n -= chunkLen
+		b.list.Remove(b.list.head)
+	}
+}
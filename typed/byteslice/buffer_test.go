@@ -0,0 +1,91 @@
+package linkedlist
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestBuffer(t *testing.T) {
+	var b Buffer
+	src := []byte("hello")
+	if _, err := b.Write(src); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure the written chunk is a copy
+	src[0] = 'j'
+	b.list.append([]byte{})
+	if _, err := io.WriteString(&b, " world"); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Len() != 11 {
+		t.Fatalf("invalid length, expected %v and received %v", 11, b.Len())
+	}
+
+	p := make([]byte, 3)
+	if n, err := b.Read(p); err != nil || string(p[:n]) != "hel" {
+		t.Fatalf("invalid read, expected %q and received %q (%v)", "hel", p[:n], err)
+	}
+
+	rest, err := ioutil.ReadAll(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(rest) != "lo world" {
+		t.Fatalf("invalid read, expected %q and received %q", "lo world", rest)
+	}
+
+	if b.Len() != 0 {
+		t.Fatalf("invalid length, expected %v and received %v", 0, b.Len())
+	}
+}
+
+func TestBufferWriteTo(t *testing.T) {
+	var b Buffer
+	b.Write([]byte("foo"))
+	b.Write([]byte("bar"))
+
+	if bufs := b.Buffers(); len(bufs) != 2 {
+		t.Fatalf("invalid buffers length, expected %v and received %v", 2, len(bufs))
+	}
+
+	var out bytes.Buffer
+	n, err := b.WriteTo(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != 6 || out.String() != "foobar" {
+		t.Fatalf("invalid write, expected %q and received %q", "foobar", out.String())
+	}
+
+	if b.Len() != 0 {
+		t.Fatalf("invalid length, expected %v and received %v", 0, b.Len())
+	}
+}
+
+func TestBufferReadFrom(t *testing.T) {
+	var b Buffer
+	n, err := io.Copy(&b, io.LimitReader(strings.NewReader("hello world"), 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != 11 || b.Len() != 11 {
+		t.Fatalf("invalid copy, expected %v bytes and received %v (%v buffered)", 11, n, b.Len())
+	}
+
+	out, err := ioutil.ReadAll(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(out) != "hello world" {
+		t.Fatalf("invalid read, expected %q and received %q", "hello world", out)
+	}
+}
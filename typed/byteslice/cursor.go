@@ -0,0 +1,197 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// Cursor will return a cursor positioned on the provided node
+// Note: A nil node will position the cursor before the head
+func (l *LinkedList) Cursor(n *Node) (c *Cursor) {
+	return &Cursor{list: l, node: n}
+}
+
+// Cursor is a position within a list, it is either on a node, before the head, or after the tail
+// Note: When the node under the cursor is removed by other means (eg. another cursor), the cursor moves
+// to the node which followed it. If no node followed it, the cursor moves to the node which preceded it.
+// When the node under the cursor is moved into another list (eg. by Extract), its former neighbors are
+// unknown and the cursor moves before the head.
+type Cursor struct {
+	list *LinkedList
+	node *Node
+	// Denotes the cursor is after the tail, only applies when node is nil
+	afterTail bool
+}
+
+// Next will move the cursor to the next node, false is returned when the cursor has moved after the tail
+func (c *Cursor) Next() (ok bool) {
+	c.sync()
+	switch {
+	case c.node != nil:
+		c.node = c.node.next
+	case !c.afterTail:
+		// Cursor is before the head, move to the head
+		c.node = c.list.head
+	}
+
+	c.afterTail = c.node == nil
+	return !c.afterTail
+}
+
+// Prev will move the cursor to the previous node, false is returned when the cursor has moved before the head
+func (c *Cursor) Prev() (ok bool) {
+	c.sync()
+	switch {
+	case c.node != nil:
+		c.node = c.node.prev
+	case c.afterTail:
+		// Cursor is after the tail, move to the tail
+		c.node = c.list.tail
+	}
+
+	c.afterTail = false
+	return c.node != nil
+}
+
+// Node will return the node under the cursor, nil is returned when the cursor is before the head or after the tail
+func (c *Cursor) Node() (n *Node) {
+	c.sync()
+	return c.node
+}
+
+// Value will return the value under the cursor, false is returned when the cursor is not on a node
+func (c *Cursor) Value() (val []byte, ok bool) {
+	if c.sync(); c.node == nil {
+		return
+	}
+
+	return c.node.val, true
+}
+
+// Set will set the value under the cursor, false is returned when the cursor is not on a node
+func (c *Cursor) Set(val []byte) (ok bool) {
+	if c.sync(); c.node == nil {
+		return
+	}
+
+	c.node.val = val
+	return true
+}
+
+// InsertBefore will insert a value before the cursor, the reference node is Returned
+// Note: When the cursor is before the head, the value is inserted at the head
+func (c *Cursor) InsertBefore(val []byte) (n *Node) {
+	c.sync()
+	switch {
+	case c.node != nil:
+		return c.list.insertBefore(c.node, val)
+	case c.afterTail:
+		return c.list.append(val)
+
+	default:
+		return c.list.prepend(val)
+	}
+}
+
+// InsertAfter will insert a value after the cursor, the reference node is Returned
+// Note: When the cursor is after the tail, the value is inserted at the tail
+func (c *Cursor) InsertAfter(val []byte) (n *Node) {
+	c.sync()
+	switch {
+	case c.node != nil:
+		return c.list.insertAfter(c.node, val)
+	case c.afterTail:
+		return c.list.append(val)
+
+	default:
+		return c.list.prepend(val)
+	}
+}
+
+// Remove will remove the node under the cursor and move the cursor to the following node. If no node
+// follows, the cursor moves to the preceding node. The removed value is Returned, false is returned when the
+// cursor is not on a node
+func (c *Cursor) Remove() (val []byte, ok bool) {
+	if c.sync(); c.node == nil {
+		return
+	}
+
+	n := c.node
+	val = n.val
+	c.list.Remove(n)
+	c.moveFrom(n)
+	return val, true
+}
+
+// sync will move the cursor off of its node when the node has been removed from the list
+func (c *Cursor) sync() {
+	if c.node == nil || c.list.contains(c.node) {
+		return
+	}
+
+	c.moveFrom(c.node)
+}
+
+// moveFrom will move the cursor to the nearest node which remains in the list, following the
+// retained links of the provided removed node
+func (c *Cursor) moveFrom(n *Node) {
+	if c.node = c.list.nearest(n.next, false); c.node != nil {
+		return
+	}
+
+	c.node = c.list.nearest(n.prev, true)
+	// When every neighbor has been removed, the cursor is before the head
+	c.afterTail = false
+}
+
+// nearest will return the first node within the list, following retained links from the provided node
+// Note: A nil node is returned when the links end, or lead to a node which has been moved into another list
+func (l *LinkedList) nearest(n *Node, reverse bool) *Node {
+	// Iterate until we find a node within the list
+	for n != nil && n.list != l {
+		if n.list != nil {
+			// Node belongs to another list, its links do not lead back into this list
+			return nil
+		}
+
+		if reverse {
+			n = n.prev
+		} else {
+			n = n.next
+		}
+	}
+
+	return n
+}
+
+// contains will return whether or not a node is linked within the list
+// Note: Removed nodes retain their links, but no longer reference an owning list. Nodes which have
+// been moved into another list (eg. by Extract) reference that list instead
+func (l *LinkedList) contains(n *Node) bool {
+	return n.list == l
+}
+
+// insertBefore will insert a value before the provided node, the reference node is Returned
+func (l *LinkedList) insertBefore(mark *Node, val []byte) (n *Node) {
+	if mark.prev == nil {
+		// Mark is the head
+		return l.prepend(val)
+	}
+
+	return l.insertAfter(mark.prev, val)
+}
+
+// insertAfter will insert a value after the provided node, the reference node is Returned
+func (l *LinkedList) insertAfter(mark *Node, val []byte) (n *Node) {
+	if mark.next == nil {
+		// Mark is the tail
+		return l.append(val)
+	}
+
+	n = newNode(mark, mark.next, val)
+	n.list = l
+	mark.next.prev = n
+	mark.next = n
+	// Increment node count
+	l.len++
+	return
+}
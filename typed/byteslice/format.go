@@ -0,0 +1,97 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+import "fmt"
+
+// DefaultFormatLimit is the maximum number of values formatted before the output is truncated
+// Note: The limit can be set per call using the precision of the verb (eg. %.10v)
+const DefaultFormatLimit = 100
+
+// String will return the list formatted as [a b c]
+// Note: String and Format use value receivers so both lists and list pointers are formatted
+func (l LinkedList) String() string {
+	return fmt.Sprintf("%v", l)
+}
+
+// Format implements fmt.Formatter, %v will format the list as [a b c], %+v will additionally include
+// the length and the address of each node, and %#v will format the list as a Go-syntax constructor
+// Note: Other verbs are applied to each value. Lists longer than the limit are truncated with an elision marker
+func (l LinkedList) Format(f fmt.State, verb rune) {
+	limit, ok := f.Precision()
+	if !ok {
+		// Precision was not provided, use the default limit
+		limit = DefaultFormatLimit
+	}
+
+	var (
+		prefix = "["
+		suffix = "]"
+		sep    = " "
+		valFmt = "%" + string(verb)
+		// Denotes node addresses are included
+		verbose bool
+	)
+
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		prefix = "linkedlist.New("
+		suffix = ")"
+		sep = ", "
+		valFmt = "%#v"
+	case verb == 'v' && f.Flag('+'):
+		prefix = fmt.Sprintf("len=%d [", l.len)
+		valFmt = "%p:%v"
+		verbose = true
+	}
+
+	fmt.Fprint(f, prefix)
+	// Number of formatted values
+	var count int
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, val []byte) bool {
+		if count == limit {
+			// We have reached our limit, end iteration
+			return true
+		}
+
+		if count > 0 {
+			// This is not the first item, write the separator
+			fmt.Fprint(f, sep)
+		}
+
+		if verbose {
+			fmt.Fprintf(f, valFmt, n, val)
+		} else {
+			fmt.Fprintf(f, valFmt, val)
+		}
+
+		count++
+		return false
+	})
+
+	if int32(count) < l.len {
+		// List was truncated, write the elision marker
+		writeFormatElision(f, verb, count, l.len)
+	}
+
+	fmt.Fprint(f, suffix)
+}
+
+// writeFormatElision will write the marker denoting a truncated list
+func writeFormatElision(f fmt.State, verb rune, count int, total int32) {
+	if verb == 'v' && f.Flag('#') {
+		// Go-syntax output, write the marker as a comment so the output remains valid
+		fmt.Fprintf(f, " /* ... %d total */", total)
+		return
+	}
+
+	if count > 0 {
+		// Values were written, write the separator
+		fmt.Fprint(f, " ")
+	}
+
+	fmt.Fprintf(f, "... (%d total)", total)
+}
@@ -0,0 +1,181 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// FlatMap will return a list of the values returned by calling the provided func for each value
+func (l *LinkedList) FlatMap(fn FlatMapFn) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val []byte) bool {
+		nl.Append(fn(val)...)
+		return false
+	})
+
+	return
+}
+
+// Take will return a list of the first n values
+func (l *LinkedList) Take(n int) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val []byte) bool {
+		if int(nl.len) >= n {
+			// We have taken n values, end iteration
+			return true
+		}
+
+		nl.append(val)
+		return false
+	})
+
+	return
+}
+
+// Skip will return a list of the values following the first n values
+func (l *LinkedList) Skip(n int) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Number of skipped values
+	var skipped int
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val []byte) bool {
+		if skipped < n {
+			skipped++
+			return false
+		}
+
+		nl.append(val)
+		return false
+	})
+
+	return
+}
+
+// TakeWhile will return a list of the leading values which match the provided func
+func (l *LinkedList) TakeWhile(fn FilterFn) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val []byte) bool {
+		if !fn(val) {
+			// Value does not match, end iteration
+			return true
+		}
+
+		nl.append(val)
+		return false
+	})
+
+	return
+}
+
+// DropWhile will return a list of the values following the leading values which match the provided func
+func (l *LinkedList) DropWhile(fn FilterFn) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Denotes the leading values have been dropped
+	var dropped bool
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val []byte) bool {
+		if !dropped && fn(val) {
+			return false
+		}
+
+		dropped = true
+		nl.append(val)
+		return false
+	})
+
+	return
+}
+
+// Partition will return a list of the values which match the provided func, and a list of the values which do not
+func (l *LinkedList) Partition(fn FilterFn) (matched, unmatched *LinkedList) {
+	matched = &LinkedList{reporter: true}
+	unmatched = &LinkedList{reporter: true}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val []byte) bool {
+		if fn(val) {
+			matched.append(val)
+		} else {
+			unmatched.append(val)
+		}
+
+		return false
+	})
+
+	return
+}
+
+// Chunk will return lists of size values, the final list may contain fewer values
+// Note: A size less than one will return no lists
+func (l *LinkedList) Chunk(size int) (chunks []*LinkedList) {
+	if size < 1 {
+		return
+	}
+
+	var chunk *LinkedList
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val []byte) bool {
+		if chunk == nil || int(chunk.len) == size {
+			// Current chunk is full, create a new chunk
+			chunk = &LinkedList{reporter: true}
+			chunks = append(chunks, chunk)
+		}
+
+		chunk.append(val)
+		return false
+	})
+
+	return
+}
+
+// Window will return sliding windows of size values, each window starts step values after the previous window
+// Note: Only full windows are returned. A size or step less than one will return no windows
+func (l *LinkedList) Window(size, step int) (windows []*LinkedList) {
+	if size < 1 || step < 1 {
+		return
+	}
+
+	// Start node of the current window
+	start := l.head
+	for start != nil {
+		window := &LinkedList{reporter: true}
+		// Iterate through each item within the window
+		l.ForEach(start, func(_ *Node, val []byte) bool {
+			window.append(val)
+			return int(window.len) == size
+		})
+
+		if int(window.len) < size {
+			// Window is not full, which means every following window will not be full
+			break
+		}
+
+		windows = append(windows, window)
+		// Move start forward by step nodes
+		for i := 0; i < step && start != nil; i++ {
+			start = start.next
+		}
+	}
+
+	return
+}
+
+// RunningReduce will return the running reduction for each value
+// Note: This is commonly referred to as a scan, the name Scan is reserved for sql.Scanner
+func (l *LinkedList) RunningReduce(fn ReduceFn) (sums [][]byte) {
+	sums = make([][]byte, 0, l.len)
+	// Running reduction
+	var sum []byte
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val []byte) bool {
+		sum = fn(sum, val)
+		sums = append(sums, sum)
+		return false
+	})
+
+	return
+}
+
+// FlatMapFn is the format of the function used to call FlatMap
+type FlatMapFn func(val []byte) (nvals [][]byte)
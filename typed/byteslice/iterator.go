@@ -0,0 +1,94 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// Iterator will return a forward iterator starting at the provided node
+// Note: A nil node will start the iterator at the head
+func (l *LinkedList) Iterator(n *Node) (it *Iterator) {
+	if n == nil {
+		// Provided node is nil, set to head
+		n = l.head
+	}
+
+	return &Iterator{list: l, next: n, mark: n}
+}
+
+// IteratorRev will return a reverse iterator starting at the provided node
+// Note: A nil node will start the iterator at the tail
+func (l *LinkedList) IteratorRev(n *Node) (it *Iterator) {
+	if n == nil {
+		// Provided node is nil, set to tail
+		n = l.tail
+	}
+
+	return &Iterator{list: l, next: n, mark: n, reverse: true}
+}
+
+// Iterator is a pull-style iterator over the nodes of a list
+// Note: Removing nodes while iterating is safe, removed nodes which have not been reached are skipped. When the
+// next node is moved into another list (eg. by Extract), the iterator is exhausted
+type Iterator struct {
+	list *LinkedList
+	// Next node to be returned
+	next *Node
+	// Marked node, set by Mark and initially the starting node
+	mark *Node
+
+	reverse bool
+}
+
+// HasNext will return whether or not the iterator has remaining values
+func (it *Iterator) HasNext() (ok bool) {
+	it.sync()
+	return it.next != nil
+}
+
+// Peek will return the next value without advancing the iterator, false is returned when the iterator is exhausted
+func (it *Iterator) Peek() (val []byte, ok bool) {
+	if it.sync(); it.next == nil {
+		return
+	}
+
+	return it.next.val, true
+}
+
+// Next will return the next value and advance the iterator, false is returned when the iterator is exhausted
+func (it *Iterator) Next() (val []byte, ok bool) {
+	if it.sync(); it.next == nil {
+		return
+	}
+
+	val = it.next.val
+	it.next = it.step(it.next)
+	return val, true
+}
+
+// Mark will mark the current position of the iterator
+func (it *Iterator) Mark() {
+	it.sync()
+	it.mark = it.next
+}
+
+// Reset will return the iterator to the marked position, or the starting position when no mark has been set
+// Note: When the marked node has been removed, the iterator resumes from the following node
+func (it *Iterator) Reset() {
+	it.next = it.mark
+}
+
+// sync will advance the next node past any nodes which have been removed from the list
+func (it *Iterator) sync() {
+	if it.next != nil {
+		it.next = it.list.nearest(it.next, it.reverse)
+	}
+}
+
+// step will return the node following the provided node in the direction of the iterator
+func (it *Iterator) step(n *Node) *Node {
+	if it.reverse {
+		return n.prev
+	}
+
+	return n.next
+}
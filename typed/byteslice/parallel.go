@@ -0,0 +1,164 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+import "sync"
+
+// ParallelMap will return a mapped copy of the list, the list is split into contiguous ranges which are mapped concurrently
+// Note: The provided func must be safe for concurrent use. A workers value less than one will use a single worker
+func (l *LinkedList) ParallelMap(workers int, fn MapFn) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	segments := l.parallelSegments(nl, workers, func(start *Node, count int, seg *LinkedList) {
+		// Iterate through each item within the range
+		for n := start; count > 0; n, count = n.next, count-1 {
+			seg.append(fn(n.val))
+		}
+	})
+
+	nl.joinSegments(segments)
+	return
+}
+
+// ParallelFilter will return a filtered copy of the list, the list is split into contiguous ranges which are filtered concurrently
+// Note: The provided func must be safe for concurrent use. A workers value less than one will use a single worker
+func (l *LinkedList) ParallelFilter(workers int, fn FilterFn) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	segments := l.parallelSegments(nl, workers, func(start *Node, count int, seg *LinkedList) {
+		// Iterate through each item within the range
+		for n := start; count > 0; n, count = n.next, count-1 {
+			if fn(n.val) {
+				seg.append(n.val)
+			}
+		}
+	})
+
+	nl.joinSegments(segments)
+	return
+}
+
+// ParallelReduce will return a reduced value, each contiguous range is reduced concurrently and the
+// results are combined in list order
+// Note: The provided funcs must be safe for concurrent use. A workers value less than one will use a single worker
+func (l *LinkedList) ParallelReduce(workers int, fn ReduceFn, combine CombineFn) (sum []byte) {
+	ranges := l.parallelRanges(workers)
+	sums := make([][]byte, len(ranges))
+	runRanges(ranges, func(i int, r parallelRange) {
+		var rsum []byte
+		// Iterate through each item within the range
+		for n, count := r.start, r.count; count > 0; n, count = n.next, count-1 {
+			rsum = fn(rsum, n.val)
+		}
+
+		sums[i] = rsum
+	})
+
+	// Iterate through each range sum
+	for i, rsum := range sums {
+		if i == 0 {
+			sum = rsum
+			continue
+		}
+
+		sum = combine(sum, rsum)
+	}
+
+	return
+}
+
+// parallelSegments will call the provided func concurrently for each range, each call populates its own segment
+// Note: The nodes of each segment are owned by the provided destination list, so the segments may be joined without
+// another pass over the nodes
+func (l *LinkedList) parallelSegments(dst *LinkedList, workers int, fn func(start *Node, count int, seg *LinkedList)) (segments []*LinkedList) {
+	ranges := l.parallelRanges(workers)
+	segments = make([]*LinkedList, len(ranges))
+	runRanges(ranges, func(i int, r parallelRange) {
+		seg := &LinkedList{}
+		fn(r.start, r.count, seg)
+		// Iterate through each node within the segment, setting the owning list as the destination list
+		for n := seg.head; n != nil; n = n.next {
+			n.list = dst
+		}
+
+		segments[i] = seg
+	})
+
+	return
+}
+
+// parallelRanges will split the list into at most workers contiguous ranges of near equal length
+func (l *LinkedList) parallelRanges(workers int) (ranges []parallelRange) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	if int(l.len) < workers {
+		// We cannot have more ranges than nodes
+		workers = int(l.len)
+	}
+
+	ranges = make([]parallelRange, 0, workers)
+	n := l.head
+	// Iterate through each range
+	for i := 0; i < workers; i++ {
+		r := parallelRange{start: n, count: int(l.len) / workers}
+		if i < int(l.len)%workers {
+			// Spread the remainder across the leading ranges
+			r.count++
+		}
+
+		ranges = append(ranges, r)
+		// Move n to the start of the next range
+		for j := 0; j < r.count; j++ {
+			n = n.next
+		}
+	}
+
+	return
+}
+
+// runRanges will call the provided func for each range within its own goroutine, waiting for every call to return
+func runRanges(ranges []parallelRange, fn func(i int, r parallelRange)) {
+	var wg sync.WaitGroup
+	wg.Add(len(ranges))
+	for i, r := range ranges {
+		go func(i int, r parallelRange) {
+			defer wg.Done()
+			fn(i, r)
+		}(i, r)
+	}
+
+	wg.Wait()
+}
+
+// joinSegments will link the provided segments, in order, onto the end of the list without copying nodes
+func (l *LinkedList) joinSegments(segments []*LinkedList) {
+	for _, seg := range segments {
+		if seg.head == nil {
+			// Segment is empty, nothing to link
+			continue
+		}
+
+		if l.tail == nil {
+			l.head = seg.head
+		} else {
+			l.tail.next = seg.head
+			seg.head.prev = l.tail
+		}
+
+		l.tail = seg.tail
+		l.len += seg.len
+	}
+
+	return
+}
+
+// parallelRange is a contiguous range of nodes
+type parallelRange struct {
+	start *Node
+	count int
+}
+
+// CombineFn is the format of the function used to combine reduced values within ParallelReduce
+type CombineFn func(a, b []byte) (sum []byte)
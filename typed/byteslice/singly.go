@@ -0,0 +1,257 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// SinglyLinkedList is a simple singly-linked list
+type SinglyLinkedList struct {
+	head *SinglyNode
+	tail *SinglyNode
+
+	reporter bool
+	len      int32
+}
+
+// pushFront will prepend the list with a value, the reference node is Returned
+func (l *SinglyLinkedList) pushFront(val []byte) (n *SinglyNode) {
+	n = newSinglyNode(l.head, val)
+
+	if l.tail == nil {
+		// This is the first item, so it will be the head AND the tail
+		l.tail = n
+	}
+
+	// Set head as our new node
+	l.head = n
+	// Increment node count
+	l.len++
+	return
+}
+
+// append will append the list with a value, the reference node is Returned
+func (l *SinglyLinkedList) append(val []byte) (n *SinglyNode) {
+	n = newSinglyNode(nil, val)
+
+	if l.tail != nil {
+		// Tail exists, set the next value to our new node
+		l.tail.next = n
+	}
+
+	if l.head == nil {
+		// This is the first item, so it will be the head AND the tail
+		l.head = n
+	}
+
+	// Set tail as our new node
+	l.tail = n
+	// Increment node count
+	l.len++
+	return
+}
+
+// mapCopy will return a copied and mapped list
+func (l *SinglyLinkedList) mapCopy(fn MapFn) (nl *SinglyLinkedList) {
+	nl = &SinglyLinkedList{reporter: true}
+	// Iterate through each item
+	l.ForEach(nil, func(n *SinglyNode, val []byte) bool {
+		nl.append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// mapModify will modify and return mapped list
+func (l *SinglyLinkedList) mapModify(fn MapFn) (nl *SinglyLinkedList) {
+	nl = l
+	// Iterate through each item
+	l.ForEach(nil, func(n *SinglyNode, val []byte) bool {
+		n.val = fn(val)
+		return false
+	})
+
+	return
+}
+
+// filterCopy will return a copied and filtered list
+func (l *SinglyLinkedList) filterCopy(fn FilterFn) (nl *SinglyLinkedList) {
+	nl = &SinglyLinkedList{reporter: true}
+	// Iterate through each item
+	l.ForEach(nil, func(_ *SinglyNode, val []byte) bool {
+		if fn(val) {
+			nl.append(val)
+		}
+
+		return false
+	})
+
+	return
+}
+
+// filterModify will modify and return filtered list
+func (l *SinglyLinkedList) filterModify(fn FilterFn) (nl *SinglyLinkedList) {
+	nl = l
+	// Previous kept node
+	var pn *SinglyNode
+	// Iterate through each item
+	l.ForEach(nil, func(n *SinglyNode, val []byte) bool {
+		if fn(val) {
+			// Value is kept, set as our previous node
+			pn = n
+			return false
+		}
+
+		l.removeAfter(pn, n)
+		return false
+	})
+
+	return
+}
+
+// removeAfter will remove a node which directly follows the provided previous node
+// Note: A nil previous node indicates n is the head
+func (l *SinglyLinkedList) removeAfter(pn, n *SinglyNode) {
+	if pn != nil {
+		// Set previous node's next as our current next node
+		pn.next = n.next
+	} else {
+		// We have no previous, which means this is the head node
+		// Set head as the node which proceeds this one
+		l.head = n.next
+	}
+
+	if l.tail == n {
+		// This is the tail node, set tail as the previous node
+		l.tail = pn
+	}
+
+	// Set node to zero values
+	n.next = nil
+	n.val = zeroVal
+	// Decrement node count
+	l.len--
+}
+
+// PushFront will prepend the list with the provided values
+func (l *SinglyLinkedList) PushFront(vals ...[]byte) {
+	// Iterate through provided values
+	for _, val := range vals {
+		l.pushFront(val)
+	}
+}
+
+// PopFront will remove the head of the list and return its value
+func (l *SinglyLinkedList) PopFront() (val []byte, ok bool) {
+	if l.head == nil {
+		// List is empty, return early
+		return
+	}
+
+	val = l.head.val
+	l.removeAfter(nil, l.head)
+	return val, true
+}
+
+// Append will append the list with the provided values
+func (l *SinglyLinkedList) Append(vals ...[]byte) {
+	// Iterate through provided values
+	for _, val := range vals {
+		l.append(val)
+	}
+}
+
+// ForEach will iterate through each node within the linked list
+func (l *SinglyLinkedList) ForEach(n *SinglyNode, fn SinglyForEachFn) (ended bool) {
+	if n == nil {
+		// Provided node is nil, set to head
+		n = l.head
+	}
+
+	// Next node
+	var nn *SinglyNode
+	// Iterate until n equals nil
+	for n != nil {
+		// Set next node
+		nn = n.next
+		// Call provided func
+		if fn(n, n.val) {
+			// Func returned true, return with ended as true
+			return true
+		}
+
+		// Set n as the next node
+		n = nn
+	}
+
+	return false
+}
+
+// Map will return a mapped list
+func (l *SinglyLinkedList) Map(fn MapFn) (nl *SinglyLinkedList) {
+	if l.reporter {
+		return l.mapModify(fn)
+	}
+
+	return l.mapCopy(fn)
+}
+
+// Filter will return a filtered list
+func (l *SinglyLinkedList) Filter(fn FilterFn) (nl *SinglyLinkedList) {
+	if l.reporter {
+		return l.filterModify(fn)
+	}
+
+	return l.filterCopy(fn)
+}
+
+// Reduce will return a reduced value
+func (l *SinglyLinkedList) Reduce(fn ReduceFn) (sum []byte) {
+	// Iterate through each item
+	l.ForEach(nil, func(_ *SinglyNode, val []byte) bool {
+		sum = fn(sum, val)
+		return false
+	})
+
+	return
+}
+
+// Slice will return a slice of the current linked list
+func (l *SinglyLinkedList) Slice() (s [][]byte) {
+	s = make([][]byte, 0, l.len)
+	l.ForEach(nil, func(_ *SinglyNode, val []byte) bool {
+		s = append(s, val)
+		return false
+	})
+
+	return
+}
+
+// Val will return the value for a given node
+func (l *SinglyLinkedList) Val(n *SinglyNode) (val []byte) {
+	return n.val
+}
+
+// Update will update the value for a given node
+func (l *SinglyLinkedList) Update(n *SinglyNode, val []byte) {
+	n.val = val
+}
+
+// Len will return the current length of the linked list
+func (l *SinglyLinkedList) Len() (n int32) {
+	return l.len
+}
+
+func newSinglyNode(next *SinglyNode, val []byte) *SinglyNode {
+	return &SinglyNode{next, val}
+}
+
+// SinglyNode is a value container for a singly-linked list
+type SinglyNode struct {
+	next *SinglyNode
+
+	val []byte
+}
+
+// SinglyForEachFn is the format of the function used to call SinglyLinkedList.ForEach
+type SinglyForEachFn func(n *SinglyNode, val []byte) (end bool)
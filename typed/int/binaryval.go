@@ -0,0 +1,31 @@
+package linkedlist
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// ErrBinaryValueRange is returned when decoding a value which cannot be represented as an int on this platform
+var ErrBinaryValueRange = errors.New("binary value out of range")
+
+// writeBinaryVal will write a value as a zig-zag varint
+func writeBinaryVal(w io.Writer, buf []byte, val int) (err error) {
+	_, err = w.Write(buf[:binary.PutVarint(buf, int64(val))])
+	return
+}
+
+// readBinaryVal will read a value from a zig-zag varint
+func readBinaryVal(r binaryReader) (val int, err error) {
+	var v int64
+	if v, err = binary.ReadVarint(r); err != nil {
+		return
+	}
+
+	if int64(int(v)) != v {
+		// Value does not fit within our type
+		return 0, ErrBinaryValueRange
+	}
+
+	return int(v), nil
+}
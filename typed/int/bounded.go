@@ -0,0 +1,108 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+const (
+	// OverwriteOldest will evict the head of a full list to make room for a new value
+	OverwriteOldest BoundedPolicy = iota
+	// RejectNewest will reject new values while the list is full
+	RejectNewest
+)
+
+// NewBoundedList will return a new bounded list with the provided capacity and policy
+// Note: onEvict is optional and is called with each value evicted by OverwriteOldest
+func NewBoundedList(capacity int32, policy BoundedPolicy, onEvict EvictFn) *BoundedList {
+	return &BoundedList{capacity: capacity, policy: policy, onEvict: onEvict}
+}
+
+// BoundedList is a fixed-capacity doubly-linked list
+type BoundedList struct {
+	list LinkedList
+
+	capacity int32
+	policy   BoundedPolicy
+	onEvict  EvictFn
+}
+
+// append will append the list with a value, the reference node is Returned
+// Note: A nil node is returned when the value is rejected
+func (l *BoundedList) append(val int) (n *Node) {
+	if l.list.len < l.capacity {
+		// We have room for a new node
+		return l.list.append(val)
+	}
+
+	if l.policy == RejectNewest || l.list.head == nil {
+		// Our policy rejects new values when full, or we have no capacity at all
+		return
+	}
+
+	// Set our node as the oldest node
+	n = l.list.head
+	// Set the evicted value before the node is zeroed
+	evicted := n.val
+	// Remove the oldest node and reuse it for our new value
+	l.list.Remove(n)
+	n.val = val
+	l.list.appendNode(n)
+
+	if l.onEvict != nil {
+		// Notify the eviction func of the evicted value
+		l.onEvict(evicted)
+	}
+
+	return
+}
+
+// Append will append the list with the provided values, the number of appended values is Returned
+func (l *BoundedList) Append(vals ...int) (appended int) {
+	// Iterate through provided values
+	for _, val := range vals {
+		if l.append(val) == nil {
+			// Value was rejected, the remaining values will be rejected as well
+			break
+		}
+
+		appended++
+	}
+
+	return
+}
+
+// Remove will remove a node from a list
+func (l *BoundedList) Remove(n *Node) {
+	l.list.Remove(n)
+}
+
+// ForEach will iterate through each node within the linked list
+func (l *BoundedList) ForEach(n *Node, fn ForEachFn) (ended bool) {
+	return l.list.ForEach(n, fn)
+}
+
+// ForEachRev will iterate through each node within the linked list in reverse
+func (l *BoundedList) ForEachRev(n *Node, fn ForEachFn) (ended bool) {
+	return l.list.ForEachRev(n, fn)
+}
+
+// Slice will return a slice of the current linked list
+func (l *BoundedList) Slice() (s []int) {
+	return l.list.Slice()
+}
+
+// Len will return the current length of the linked list
+func (l *BoundedList) Len() (n int32) {
+	return l.list.len
+}
+
+// Cap will return the capacity of the linked list
+func (l *BoundedList) Cap() (n int32) {
+	return l.capacity
+}
+
+// BoundedPolicy represents how a bounded list handles values appended while full
+type BoundedPolicy uint8
+
+// EvictFn is the format of the function called when a bounded list evicts a value
+type EvictFn func(val int)
@@ -0,0 +1,21 @@
+package linkedlist
+
+import (
+	"strconv"
+	"strings"
+)
+
+// formatSQLVal will format a value as a Postgres array element
+func formatSQLVal(val int) (str string, quote bool) {
+	return strconv.Itoa(val), false
+}
+
+// parseSQLVal will parse a value from a Postgres array element
+func parseSQLVal(raw string, quoted bool) (val int, err error) {
+	var v int64
+	if v, err = strconv.ParseInt(strings.TrimSpace(raw), 10, 0); err != nil {
+		return
+	}
+
+	return int(v), nil
+}
@@ -0,0 +1,94 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// Zip will return a list of values combined pairwise from a and b, stopping at the end of the shorter list
+func Zip(a, b *LinkedList, fn ZipFn) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Iterate until either list has ended
+	for an, bn := a.head, b.head; an != nil && bn != nil; an, bn = an.next, bn.next {
+		nl.append(fn(an.val, bn.val))
+	}
+
+	return
+}
+
+// ZipFill will return a list of values combined pairwise from a and b, the shorter list is padded with fill
+func ZipFill(a, b *LinkedList, fill int, fn ZipFn) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Iterate until both lists have ended
+	for an, bn := a.head, b.head; an != nil || bn != nil; {
+		av, bv := fill, fill
+		if an != nil {
+			av = an.val
+			an = an.next
+		}
+
+		if bn != nil {
+			bv = bn.val
+			bn = bn.next
+		}
+
+		nl.append(fn(av, bv))
+	}
+
+	return
+}
+
+// Interleave will return a list which alternates between the values of each list, exhausted lists are skipped
+func Interleave(lists ...*LinkedList) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	// Current node for each list
+	nodes := make([]*Node, 0, len(lists))
+	for _, l := range lists {
+		if l.head != nil {
+			nodes = append(nodes, l.head)
+		}
+	}
+
+	// Iterate until every list has been exhausted
+	for len(nodes) > 0 {
+		// Remaining nodes, reusing the backing array of nodes
+		remaining := nodes[:0]
+		for _, n := range nodes {
+			nl.append(n.val)
+			if n.next != nil {
+				remaining = append(remaining, n.next)
+			}
+		}
+
+		nodes = remaining
+	}
+
+	return
+}
+
+// ForEachPair will iterate through each pair of adjacent nodes within the linked list
+func (l *LinkedList) ForEachPair(fn PairFn) (ended bool) {
+	if l.head == nil {
+		// List is empty, return early
+		return false
+	}
+
+	// Previous node
+	prev := l.head
+	// Iterate until we run out of pairs
+	for cur := prev.next; cur != nil; cur = cur.next {
+		if fn(prev, cur) {
+			// Func returned true, return with ended as true
+			return true
+		}
+
+		prev = cur
+	}
+
+	return false
+}
+
+// ZipFn is the format of the function used to call Zip
+type ZipFn func(a, b int) (val int)
+
+// PairFn is the format of the function used to call ForEachPair
+type PairFn func(prev, cur *Node) (end bool)
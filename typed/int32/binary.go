@@ -0,0 +1,162 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// ErrInvalidBinaryLength is returned when decoding a value whose length prefix is out of range
+var ErrInvalidBinaryLength = errors.New("invalid binary length")
+
+// MarshalBinary will marshal the list in its compact binary format
+func (l *LinkedList) MarshalBinary() (bs []byte, err error) {
+	var buf bytes.Buffer
+	if _, err = l.WriteTo(&buf); err != nil {
+		return
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary will unmarshal a list from its compact binary format, decoded values replace the existing values of the list
+// Note: The list is left unchanged when an error is returned
+func (l *LinkedList) UnmarshalBinary(bs []byte) (err error) {
+	var nl LinkedList
+	if _, err = nl.ReadFrom(bytes.NewReader(bs)); err != nil {
+		return
+	}
+
+	l.replace(&nl)
+	return
+}
+
+// WriteTo will write the list to a writer in its compact binary format
+// The format is a varint length prefix followed by each encoded value
+func (l *LinkedList) WriteTo(w io.Writer) (n int64, err error) {
+	cw := binaryCountWriter{w: w}
+	bw := bufio.NewWriter(&cw)
+	// Scratch buffer used for varint encoding
+	buf := make([]byte, binary.MaxVarintLen64)
+
+	// Write the length prefix
+	if _, err = bw.Write(buf[:binary.PutUvarint(buf, uint64(l.len))]); err != nil {
+		return cw.n, err
+	}
+
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int32) bool {
+		err = writeBinaryVal(bw, buf, val)
+		return err != nil
+	})
+
+	if err != nil {
+		return cw.n, err
+	}
+
+	err = bw.Flush()
+	return cw.n, err
+}
+
+// ReadFrom will read a list from a reader in its compact binary format, decoded values are appended to the list
+// Note: The reader is never read past the end of the list, readers which do not implement io.ByteReader are read
+// one byte at a time while decoding varints
+func (l *LinkedList) ReadFrom(r io.Reader) (n int64, err error) {
+	cr := binaryCountReader{r: r}
+
+	var length uint64
+	if length, err = binary.ReadUvarint(&cr); err != nil {
+		return cr.n, err
+	}
+
+	for i := uint64(0); i < length; i++ {
+		var val int32
+		if val, err = readBinaryVal(&cr); err != nil {
+			if err == io.EOF {
+				// The list ended before all of its values were read
+				err = io.ErrUnexpectedEOF
+			}
+
+			return cr.n, err
+		}
+
+		l.append(val)
+	}
+
+	return cr.n, nil
+}
+
+// binaryReader is the reader used to decode binary values
+type binaryReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// binaryCountWriter will count the bytes written to the underlying writer
+type binaryCountWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *binaryCountWriter) Write(bs []byte) (n int, err error) {
+	n, err = c.w.Write(bs)
+	c.n += int64(n)
+	return
+}
+
+// binaryCountReader will count the bytes read from the underlying reader
+type binaryCountReader struct {
+	r io.Reader
+	n int64
+	// Scratch buffer used to read single bytes from readers which do not implement io.ByteReader
+	buf [1]byte
+}
+
+func (c *binaryCountReader) Read(bs []byte) (n int, err error) {
+	n, err = c.r.Read(bs)
+	c.n += int64(n)
+	return
+}
+
+func (c *binaryCountReader) ReadByte() (b byte, err error) {
+	if br, ok := c.r.(io.ByteReader); ok {
+		if b, err = br.ReadByte(); err == nil {
+			c.n++
+		}
+
+		return
+	}
+
+	if _, err = io.ReadFull(c, c.buf[:]); err != nil {
+		return
+	}
+
+	return c.buf[0], nil
+}
+
+// readBinaryBytes will read length bytes from a reader
+// Note: Memory is grown as bytes arrive, so a corrupt length cannot cause a large allocation
+func readBinaryBytes(r io.Reader, length uint64) (bs []byte, err error) {
+	if length > math.MaxInt64 {
+		return nil, ErrInvalidBinaryLength
+	}
+
+	var buf bytes.Buffer
+	if _, err = io.CopyN(&buf, r, int64(length)); err != nil {
+		if err == io.EOF {
+			// The value ended before all of its bytes were read
+			err = io.ErrUnexpectedEOF
+		}
+
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
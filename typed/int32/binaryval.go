@@ -0,0 +1,32 @@
+package linkedlist
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// ErrBinaryValueRange is returned when decoding a value which cannot be represented as an int32
+var ErrBinaryValueRange = errors.New("binary value out of range")
+
+// writeBinaryVal will write a value as a zig-zag varint
+func writeBinaryVal(w io.Writer, buf []byte, val int32) (err error) {
+	_, err = w.Write(buf[:binary.PutVarint(buf, int64(val))])
+	return
+}
+
+// readBinaryVal will read a value from a zig-zag varint
+func readBinaryVal(r binaryReader) (val int32, err error) {
+	var v int64
+	if v, err = binary.ReadVarint(r); err != nil {
+		return
+	}
+
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		// Value does not fit within our type
+		return 0, ErrBinaryValueRange
+	}
+
+	return int32(v), nil
+}
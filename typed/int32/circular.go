@@ -0,0 +1,244 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// CircularList is a doubly-linked list where the tail links back to the head
+type CircularList struct {
+	head *Node
+
+	len int32
+}
+
+// insert will insert a value before the head, the reference node is Returned
+// Note: Within a ring, the node before the head is the tail
+func (l *CircularList) insert(val int32) (n *Node) {
+	n = newNode(nil, nil, val)
+
+	if l.head == nil {
+		// This is the first item, so it links to itself in both directions
+		n.prev = n
+		n.next = n
+		l.head = n
+	} else {
+		// Link the node between the tail and the head
+		n.prev = l.head.prev
+		n.next = l.head
+		l.head.prev.next = n
+		l.head.prev = n
+	}
+
+	// Increment node count
+	l.len++
+	return
+}
+
+// Prepend will prepend the list with the provided values
+func (l *CircularList) Prepend(vals ...int32) {
+	// Iterate through provided values
+	for _, val := range vals {
+		// Insert before the head, and set the new node as the head
+		l.head = l.insert(val)
+	}
+}
+
+// Append will append the list with the provided values
+func (l *CircularList) Append(vals ...int32) {
+	// Iterate through provided values
+	for _, val := range vals {
+		l.insert(val)
+	}
+}
+
+// Remove will remove a node from a list
+func (l *CircularList) Remove(n *Node) {
+	if n.next == n {
+		// This is the only node within the ring
+		l.head = nil
+	} else {
+		// Link the neighboring nodes to each other
+		n.prev.next = n.next
+		n.next.prev = n.prev
+
+		if l.head == n {
+			// This is the head node, set head as the node which proceeds this one
+			l.head = n.next
+		}
+	}
+
+	// Set node to zero values
+	n.prev = nil
+	n.next = nil
+	n.val = zeroVal
+	// Decrement node count
+	l.len--
+}
+
+// Head will return the head node of the list
+func (l *CircularList) Head() (n *Node) {
+	return l.head
+}
+
+// Next will return the node which proceeds the provided node, wrapping from the tail to the head
+func (l *CircularList) Next(n *Node) (nn *Node) {
+	return n.next
+}
+
+// Prev will return the node which precedes the provided node, wrapping from the head to the tail
+func (l *CircularList) Prev(n *Node) (pn *Node) {
+	return n.prev
+}
+
+// Advance will return the node k steps away from the provided node
+// Note: A negative k will move backwards through the ring
+func (l *CircularList) Advance(n *Node, k int) (an *Node) {
+	if n == nil || l.len == 0 {
+		return n
+	}
+
+	// Trim full revolutions of the ring
+	if k %= int(l.len); k < 0 {
+		// Moving backwards, step through the previous nodes
+		for ; k < 0; k++ {
+			n = n.prev
+		}
+
+		return n
+	}
+
+	for ; k > 0; k-- {
+		n = n.next
+	}
+
+	return n
+}
+
+// Rotate will move the head of the list k steps
+// Note: A negative k will move the head backwards through the ring
+func (l *CircularList) Rotate(k int) {
+	l.head = l.Advance(l.head, k)
+}
+
+// RoundRobin will return the head node and rotate the head to the following node
+// Note: Nil is returned when the list is empty
+func (l *CircularList) RoundRobin() (n *Node) {
+	if n = l.head; n != nil {
+		l.head = n.next
+	}
+
+	return
+}
+
+// RoundRobinVal will return the head value and rotate the head to the following node
+func (l *CircularList) RoundRobinVal() (val int32, ok bool) {
+	n := l.RoundRobin()
+	if n == nil {
+		// List is empty, return early
+		return
+	}
+
+	return n.val, true
+}
+
+// ForEach will iterate through each node within the ring exactly once, starting at the provided node
+// Note: The provided func may remove nodes, removed nodes which have not been reached are skipped
+func (l *CircularList) ForEach(n *Node, fn ForEachFn) (ended bool) {
+	if n == nil {
+		// Provided node is nil, set to head
+		n = l.head
+	}
+
+	return l.iterate(n, fn, false)
+}
+
+// ForEachRev will iterate through each node within the ring exactly once in reverse, starting at the provided node
+// Note: The provided func may remove nodes, removed nodes which have not been reached are skipped
+func (l *CircularList) ForEachRev(n *Node, fn ForEachFn) (ended bool) {
+	if n == nil && l.head != nil {
+		// Provided node is nil, set to tail
+		n = l.head.prev
+	}
+
+	return l.iterate(n, fn, true)
+}
+
+// iterate will iterate through each node within the ring exactly once, starting at the provided node
+// Note: Removed nodes have their links cleared, so the next and final nodes are re-read when they are removed
+func (l *CircularList) iterate(n *Node, fn ForEachFn, reverse bool) (ended bool) {
+	if n == nil || l.len == 0 {
+		// Ring is empty, return early
+		return false
+	}
+
+	// Final node to be visited
+	last := stepNode(n, !reverse)
+	for {
+		// Set next node, and the node preceding the final node
+		nn, beforeLast := stepNode(n, reverse), stepNode(last, !reverse)
+		// Call provided func
+		if fn(n, n.val) {
+			// Func returned true, return with ended as true
+			return true
+		}
+
+		if n == last || l.len == 0 {
+			// We have visited the final node, or the ring has been emptied
+			return false
+		}
+
+		if last.next == nil {
+			// Final node was removed, the node which preceded it is now the final node
+			if last = beforeLast; last == n || last.next == nil {
+				// No unvisited nodes remain
+				return false
+			}
+		}
+
+		if nn.next == nil {
+			// Next node was removed, re-read it from the current node
+			if nn = stepNode(n, reverse); nn == nil {
+				// Current node was removed as well, we cannot continue
+				return false
+			}
+		}
+
+		// Set n as the next node
+		n = nn
+	}
+}
+
+// Slice will return a slice of the current ring, starting at the head
+func (l *CircularList) Slice() (s []int32) {
+	s = make([]int32, 0, l.len)
+	l.ForEach(nil, func(_ *Node, val int32) bool {
+		s = append(s, val)
+		return false
+	})
+
+	return
+}
+
+// Val will return the value for a given node
+func (l *CircularList) Val(n *Node) (val int32) {
+	return n.val
+}
+
+// Update will update the value for a given node
+func (l *CircularList) Update(n *Node, val int32) {
+	n.val = val
+}
+
+// Len will return the current length of the ring
+func (l *CircularList) Len() (n int32) {
+	return l.len
+}
+
+// stepNode will return the node which follows the provided node, or precedes it when reverse is true
+func stepNode(n *Node, reverse bool) *Node {
+	if reverse {
+		return n.prev
+	}
+
+	return n.next
+}
@@ -0,0 +1,91 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+import "context"
+
+// CtxCheckInterval is the number of nodes visited by ForEachCtx between each cancellation check
+const CtxCheckInterval = 64
+
+// ForEachErr will iterate through each node within the linked list, stopping at the first error
+func (l *LinkedList) ForEachErr(n *Node, fn ForEachErrFn) (err error) {
+	l.ForEach(n, func(n *Node, val int32) bool {
+		err = fn(n, val)
+		return err != nil
+	})
+
+	return
+}
+
+// ForEachCtx will iterate through each node within the linked list, stopping when the context is done
+// Note: The context is checked before the first node and every CtxCheckInterval nodes thereafter
+func (l *LinkedList) ForEachCtx(ctx context.Context, n *Node, fn ForEachFn) (err error) {
+	// Number of visited nodes
+	var visited int
+	l.ForEach(n, func(n *Node, val int32) bool {
+		if visited%CtxCheckInterval == 0 {
+			if err = ctx.Err(); err != nil {
+				// Context is done, end iteration
+				return true
+			}
+		}
+
+		visited++
+		return fn(n, val)
+	})
+
+	return
+}
+
+// MapErr will return a mapped copy of the list, stopping at the first error
+func (l *LinkedList) MapErr(fn MapErrFn) (nl *LinkedList, err error) {
+	nl = &LinkedList{reporter: true}
+	// Iterate through each item
+	err = l.ForEachErr(nil, func(_ *Node, val int32) (err error) {
+		var nval int32
+		if nval, err = fn(val); err != nil {
+			return
+		}
+
+		nl.append(nval)
+		return
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// FilterErr will return a filtered copy of the list, stopping at the first error
+func (l *LinkedList) FilterErr(fn FilterErrFn) (nl *LinkedList, err error) {
+	nl = &LinkedList{reporter: true}
+	// Iterate through each item
+	err = l.ForEachErr(nil, func(_ *Node, val int32) (err error) {
+		var ok bool
+		if ok, err = fn(val); err != nil || !ok {
+			return
+		}
+
+		nl.append(val)
+		return
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// ForEachErrFn is the format of the function used to call ForEachErr
+type ForEachErrFn func(n *Node, val int32) (err error)
+
+// MapErrFn is the format of the function used to call MapErr
+type MapErrFn func(val int32) (nval int32, err error)
+
+// FilterErrFn is the format of the function used to call FilterErr
+type FilterErrFn func(val int32) (ok bool, err error)
@@ -0,0 +1,134 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// NewMPSCQueue will return a new multi-producer, single-consumer queue
+func NewMPSCQueue() *MPSCQueue {
+	return &MPSCQueue{}
+}
+
+// MPSCQueue is a lock-free multi-producer, single-consumer linked queue
+// Note: Push is safe for concurrent use, TryPop must only be called by a single goroutine. The zero value
+// is an empty queue, a queue must not be copied after first use
+type MPSCQueue struct {
+	noCopy noCopy
+	// Most recently pushed node, swapped atomically by producers
+	head unsafe.Pointer
+	// Most recently popped node, only accessed by the consumer
+	tail *queueNode
+	// Initial node, the queue is empty while head and tail are the same node
+	stub queueNode
+}
+
+// Push will push a value to the back of the queue
+func (q *MPSCQueue) Push(val int32) {
+	n := &queueNode{val: val}
+	if atomic.LoadPointer(&q.head) == nil {
+		// Queue has not been used, set head as the stub. A concurrent Push may have already done so
+		atomic.CompareAndSwapPointer(&q.head, nil, unsafe.Pointer(&q.stub))
+	}
+
+	// Swap the head with our new node
+	prev := (*queueNode)(atomic.SwapPointer(&q.head, unsafe.Pointer(n)))
+	// Link the previous head to our new node, making it visible to the consumer
+	atomic.StorePointer(&prev.next, unsafe.Pointer(n))
+}
+
+// TryPop will pop a value from the front of the queue
+// Note: ok will be false if the queue is empty or a concurrent Push has not completed linking
+func (q *MPSCQueue) TryPop() (val int32, ok bool) {
+	if q.tail == nil {
+		// Queue has not been used, set tail as the stub
+		q.tail = &q.stub
+	}
+
+	next := (*queueNode)(atomic.LoadPointer(&q.tail.next))
+	if next == nil {
+		// Queue is empty, return early
+		return
+	}
+
+	// Set tail as the popped node, it becomes the new stub
+	q.tail = next
+	val = next.val
+	// Set value to zero value so it may be collected
+	next.val = zeroVal
+	return val, true
+}
+
+// NewSPSCQueue will return a new single-producer, single-consumer queue
+func NewSPSCQueue() *SPSCQueue {
+	return &SPSCQueue{}
+}
+
+// SPSCQueue is a lock-free single-producer, single-consumer linked queue
+// Note: Push must only be called by a single goroutine, TryPop must only be called by a single goroutine.
+// The zero value is an empty queue, a queue must not be copied after first use
+type SPSCQueue struct {
+	noCopy noCopy
+	// Most recently pushed node, only accessed by the producer
+	head *queueNode
+	// Most recently popped node, only accessed by the consumer
+	tail *queueNode
+	// Initial node, the queue is empty while head and tail are the same node
+	stub queueNode
+}
+
+// Push will push a value to the back of the queue
+func (q *SPSCQueue) Push(val int32) {
+	n := &queueNode{val: val}
+	if q.head == nil {
+		// Queue has not been used, set head as the stub
+		q.head = &q.stub
+	}
+
+	// Link the current head to our new node, making it visible to the consumer
+	atomic.StorePointer(&q.head.next, unsafe.Pointer(n))
+	// Set head as our new node
+	q.head = n
+}
+
+// TryPop will pop a value from the front of the queue
+// Note: ok will be false if the queue is empty
+func (q *SPSCQueue) TryPop() (val int32, ok bool) {
+	if q.tail == nil {
+		// Queue has not been used, set tail as the stub
+		q.tail = &q.stub
+	}
+
+	next := (*queueNode)(atomic.LoadPointer(&q.tail.next))
+	if next == nil {
+		// Queue is empty, return early
+		return
+	}
+
+	// Set tail as the popped node, it becomes the new stub
+	q.tail = next
+	val = next.val
+	// Set value to zero value so it may be collected
+	next.val = zeroVal
+	return val, true
+}
+
+// noCopy is embedded within types which must not be copied after first use, go vet's copylocks check reports copies
+type noCopy struct{}
+
+// Lock is a no-op used by go vet's copylocks check
+func (*noCopy) Lock() {}
+
+// Unlock is a no-op used by go vet's copylocks check
+func (*noCopy) Unlock() {}
+
+// queueNode is a value container for the lock-free queues
+type queueNode struct {
+	next unsafe.Pointer
+
+	val int32
+}
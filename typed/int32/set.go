@@ -0,0 +1,78 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// Distinct will return a list of distinct values, the first occurrence of each value is kept
+func (l *LinkedList) Distinct() (nl *LinkedList) {
+	return l.setCopy(nil, false)
+}
+
+// Union will return a list of distinct values from both lists, values from l precede values from o
+func (l *LinkedList) Union(o *LinkedList) (nl *LinkedList) {
+	nl = l.setCopy(nil, false)
+	// Set of values from the resulting list
+	seen := valSet(nl)
+	// Iterate through each item within the other list
+	o.ForEach(nil, func(_ *Node, val int32) bool {
+		if _, ok := seen[val]; ok {
+			return false
+		}
+
+		seen[val] = struct{}{}
+		nl.append(val)
+		return false
+	})
+
+	return
+}
+
+// Intersect will return a list of distinct values from l which exist within o
+func (l *LinkedList) Intersect(o *LinkedList) (nl *LinkedList) {
+	return l.setCopy(valSet(o), true)
+}
+
+// Difference will return a list of distinct values from l which do not exist within o
+func (l *LinkedList) Difference(o *LinkedList) (nl *LinkedList) {
+	return l.setCopy(valSet(o), false)
+}
+
+// setCopy will return a list of distinct values, the first occurrence of each value is kept
+// Note: When a filter set is provided, values are only kept when their existence matches inFilter
+func (l *LinkedList) setCopy(filter map[int32]struct{}, inFilter bool) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	seen := make(map[int32]struct{})
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int32) bool {
+		if _, ok := seen[val]; ok {
+			// Value has already been seen
+			return false
+		}
+
+		if filter != nil {
+			if _, ok := filter[val]; ok != inFilter {
+				// Value existence does not match
+				return false
+			}
+		}
+
+		seen[val] = struct{}{}
+		nl.append(val)
+		return false
+	})
+
+	return
+}
+
+// valSet will return the set of values for a list
+func valSet(l *LinkedList) (set map[int32]struct{}) {
+	set = make(map[int32]struct{}, l.len)
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int32) bool {
+		set[val] = struct{}{}
+		return false
+	})
+
+	return
+}
@@ -0,0 +1,21 @@
+package linkedlist
+
+import (
+	"strconv"
+	"strings"
+)
+
+// formatSQLVal will format a value as a Postgres array element
+func formatSQLVal(val int32) (str string, quote bool) {
+	return strconv.FormatInt(int64(val), 10), false
+}
+
+// parseSQLVal will parse a value from a Postgres array element
+func parseSQLVal(raw string, quoted bool) (val int32, err error) {
+	var v int64
+	if v, err = strconv.ParseInt(strings.TrimSpace(raw), 10, 32); err != nil {
+		return
+	}
+
+	return int32(v), nil
+}
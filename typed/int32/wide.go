@@ -0,0 +1,16 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// SumWide will return the sum of the values as a wider type so the sum does not overflow
+func (l *LinkedList) SumWide() (sum int64) {
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int32) bool {
+		sum += int64(val)
+		return false
+	})
+
+	return
+}
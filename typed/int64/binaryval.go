@@ -0,0 +1,22 @@
+package linkedlist
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// writeBinaryVal will write a value as a zig-zag varint
+func writeBinaryVal(w io.Writer, buf []byte, val int64) (err error) {
+	_, err = w.Write(buf[:binary.PutVarint(buf, int64(val))])
+	return
+}
+
+// readBinaryVal will read a value from a zig-zag varint
+func readBinaryVal(r binaryReader) (val int64, err error) {
+	var v int64
+	if v, err = binary.ReadVarint(r); err != nil {
+		return
+	}
+
+	return int64(v), nil
+}
@@ -0,0 +1,104 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// GroupBy will return lists of values grouped by the key returned by the provided func, order is kept within each group
+// Note: Keys must be comparable
+func (l *LinkedList) GroupBy(fn KeyFn) (groups map[interface{}]*LinkedList) {
+	groups = make(map[interface{}]*LinkedList)
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int64) bool {
+		key := fn(val)
+		group, ok := groups[key]
+		if !ok {
+			// Group does not exist, create a new group
+			group = &LinkedList{reporter: true}
+			groups[key] = group
+		}
+
+		group.append(val)
+		return false
+	})
+
+	return
+}
+
+// DistinctBy will return a list of values with a distinct key, the first occurrence of each key is kept
+func (l *LinkedList) DistinctBy(fn KeyFn) (nl *LinkedList) {
+	return l.keyedCopy(fn, nil, false)
+}
+
+// UnionBy will return a list of values with a distinct key from both lists, values from l precede values from o
+func (l *LinkedList) UnionBy(o *LinkedList, fn KeyFn) (nl *LinkedList) {
+	nl = l.keyedCopy(fn, nil, false)
+	// Set of keys from the resulting list
+	seen := keySet(nl, fn)
+	// Iterate through each item within the other list
+	o.ForEach(nil, func(_ *Node, val int64) bool {
+		key := fn(val)
+		if _, ok := seen[key]; ok {
+			return false
+		}
+
+		seen[key] = struct{}{}
+		nl.append(val)
+		return false
+	})
+
+	return
+}
+
+// IntersectBy will return a list of values from l with a distinct key which exists within o
+func (l *LinkedList) IntersectBy(o *LinkedList, fn KeyFn) (nl *LinkedList) {
+	return l.keyedCopy(fn, keySet(o, fn), true)
+}
+
+// DifferenceBy will return a list of values from l with a distinct key which does not exist within o
+func (l *LinkedList) DifferenceBy(o *LinkedList, fn KeyFn) (nl *LinkedList) {
+	return l.keyedCopy(fn, keySet(o, fn), false)
+}
+
+// keyedCopy will return a list of values with a distinct key, the first occurrence of each key is kept
+// Note: When a filter set is provided, values are only kept when their key existence matches inFilter
+func (l *LinkedList) keyedCopy(fn KeyFn, filter map[interface{}]struct{}, inFilter bool) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	seen := make(map[interface{}]struct{})
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int64) bool {
+		key := fn(val)
+		if _, ok := seen[key]; ok {
+			// Key has already been seen
+			return false
+		}
+
+		if filter != nil {
+			if _, ok := filter[key]; ok != inFilter {
+				// Key existence does not match
+				return false
+			}
+		}
+
+		seen[key] = struct{}{}
+		nl.append(val)
+		return false
+	})
+
+	return
+}
+
+// keySet will return the set of keys for a list
+func keySet(l *LinkedList, fn KeyFn) (set map[interface{}]struct{}) {
+	set = make(map[interface{}]struct{}, l.len)
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int64) bool {
+		set[fn(val)] = struct{}{}
+		return false
+	})
+
+	return
+}
+
+// KeyFn is the format of the function used to key values
+type KeyFn func(val int64) (key interface{})
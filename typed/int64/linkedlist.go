@@ -9,6 +9,13 @@ var (
 	zeroSum int64
 )
 
+// New will return a new list populated with the provided values
+func New(vals ...int64) (l *LinkedList) {
+	l = &LinkedList{}
+	l.Append(vals...)
+	return
+}
+
 // LinkedList is a simple doubly-linked list
 type LinkedList struct {
 	head *Node
@@ -21,6 +28,8 @@ type LinkedList struct {
 // prepend will prepend the list with a value, the reference node is Returned
 func (l *LinkedList) prepend(val int64) (n *Node) {
 	n = newNode(nil, l.head, val)
+	// Set the owning list of our node
+	n.list = l
 
 	if l.head != nil {
 		// Head exists, set the previous value to our new node
@@ -41,7 +50,19 @@ func (l *LinkedList) prepend(val int64) (n *Node) {
 
 // append will append the list with a value, the reference node is Returned
 func (l *LinkedList) append(val int64) (n *Node) {
-	n = newNode(l.tail, nil, val)
+	n = newNode(nil, nil, val)
+	l.appendNode(n)
+	return
+}
+
+// appendNode will append the list with an unlinked node
+func (l *LinkedList) appendNode(n *Node) {
+	// Set the previous value of our node to the current tail
+	n.prev = l.tail
+	// Clear any next value retained from a previous list position
+	n.next = nil
+	// Set the owning list of our node
+	n.list = l
 
 	if l.tail != nil {
 		// Tail exists, set the next value to our new node
@@ -57,7 +78,22 @@ func (l *LinkedList) append(val int64) (n *Node) {
 	l.tail = n
 	// Increment node count
 	l.len++
-	return
+}
+
+// replace will replace the nodes of the list with the nodes of the provided list, the nodes are moved rather than copied
+func (l *LinkedList) replace(nl *LinkedList) {
+	// Remove the existing nodes so cursors positioned on them remain well-defined
+	l.ForEach(nil, func(n *Node, _ int64) bool {
+		l.Remove(n)
+		return false
+	})
+
+	// Iterate through each item within the provided list
+	nl.ForEach(nil, func(n *Node, _ int64) bool {
+		nl.unlink(n)
+		l.appendNode(n)
+		return false
+	})
 }
 
 // mapCopy will return a copied and mapped list
@@ -135,7 +171,24 @@ func (l *LinkedList) Append(vals ...int64) {
 }
 
 // Remove will remove a node from a list
+// Note: Nodes which do not belong to the list (eg. a node which has already been removed) are ignored
 func (l *LinkedList) Remove(n *Node) {
+	if !l.unlink(n) {
+		return
+	}
+
+	// Set node value to zero value
+	n.val = zeroVal
+}
+
+// unlink will unlink a node from a list, the node value is left intact. False is returned when the node does not belong to the list
+// Note: The prev and next values are retained so cursors positioned on a removed node can find their way back into the list
+func (l *LinkedList) unlink(n *Node) (ok bool) {
+	if n.list != l {
+		// Node does not belong to this list, its links must not be used to modify our nodes
+		return false
+	}
+
 	if n.prev != nil {
 		// Set previous node's next as our current next node
 		n.prev.next = n.next
@@ -160,12 +213,11 @@ func (l *LinkedList) Remove(n *Node) {
 		}
 	}
 
-	// Set node to zero values
-	n.prev = nil
-	n.next = nil
-	n.val = zeroVal
+	// Clear the owning list, the node no longer belongs to a list
+	n.list = nil
 	// Decrement node count
 	l.len--
+	return true
 }
 
 // ForEach will iterate through each node within the linked list
@@ -276,13 +328,15 @@ func (l *LinkedList) Len() (n int32) {
 }
 
 func newNode(prev, next *Node, val int64) *Node {
-	return &Node{prev, next, val}
+	return &Node{prev: prev, next: next, val: val}
 }
 
 // Node is a value container
 type Node struct {
 	prev *Node
 	next *Node
+	// List the node is linked within, nil when the node has been removed
+	list *LinkedList
 
 	val int64
 }
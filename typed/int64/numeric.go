@@ -0,0 +1,124 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+import (
+	"math"
+	"sort"
+)
+
+// Sum will return the sum of the values
+// Note: The sum will wrap on overflow
+func (l *LinkedList) Sum() (sum int64) {
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int64) bool {
+		sum += val
+		return false
+	})
+
+	return
+}
+
+// Min will return the smallest value, ok will be false when the list is empty
+func (l *LinkedList) Min() (min int64, ok bool) {
+	min, _, ok = l.MinMax()
+	return
+}
+
+// Max will return the largest value, ok will be false when the list is empty
+func (l *LinkedList) Max() (max int64, ok bool) {
+	_, max, ok = l.MinMax()
+	return
+}
+
+// MinMax will return the smallest and largest values, ok will be false when the list is empty
+func (l *LinkedList) MinMax() (min, max int64, ok bool) {
+	if l.head == nil {
+		// List is empty, return early
+		return
+	}
+
+	min, max = l.head.val, l.head.val
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int64) bool {
+		if val < min {
+			min = val
+		}
+
+		if val > max {
+			max = val
+		}
+
+		return false
+	})
+
+	return min, max, true
+}
+
+// Mean will return the arithmetic mean of the values, ok will be false when the list is empty
+func (l *LinkedList) Mean() (mean float64, ok bool) {
+	if l.len == 0 {
+		// List is empty, return early
+		return
+	}
+
+	// Values are summed as floats so the mean does not overflow
+	var sum float64
+	l.ForEach(nil, func(_ *Node, val int64) bool {
+		sum += float64(val)
+		return false
+	})
+
+	return sum / float64(l.len), true
+}
+
+// Median will return the median of the values, ok will be false when the list is empty
+func (l *LinkedList) Median() (median float64, ok bool) {
+	return l.Percentile(50)
+}
+
+// Percentile will return the p-th percentile (0 to 100) of the values using linear interpolation
+// Note: ok will be false when the list is empty or p is out of range
+func (l *LinkedList) Percentile(p float64) (percentile float64, ok bool) {
+	if l.len == 0 || p < 0 || p > 100 || math.IsNaN(p) {
+		return
+	}
+
+	vals := l.Slice()
+	sort.Slice(vals, func(i, j int) bool {
+		return vals[i] < vals[j]
+	})
+
+	// Fractional index of the percentile within the sorted values
+	rank := p / 100 * float64(len(vals)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return float64(vals[lower]), true
+	}
+
+	// Interpolate between the two closest values
+	weight := rank - float64(lower)
+	return float64(vals[lower])*(1-weight) + float64(vals[upper])*weight, true
+}
+
+// Histogram will return the count of values within each bucket, buckets are ascending boundaries
+// counts[0] is the count of values less than buckets[0], counts[i] is the count of values within
+// [buckets[i-1], buckets[i]), and counts[len(buckets)] is the count of values at or above the final boundary
+func (l *LinkedList) Histogram(buckets []int64) (counts []int) {
+	counts = make([]int, len(buckets)+1)
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int64) bool {
+		// Find the first boundary which is greater than our value
+		i := sort.Search(len(buckets), func(i int) bool {
+			return buckets[i] > val
+		})
+
+		counts[i]++
+		return false
+	})
+
+	return
+}
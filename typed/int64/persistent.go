@@ -0,0 +1,180 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// PersistentList is an immutable list, each modification returns a new version which shares
+// unchanged nodes with the previous version. Versions are safe to share between goroutines.
+// Note: The zero value is an empty list
+type PersistentList struct {
+	// Front chain, stored in list order
+	front *PersistentNode
+	// Back chain, stored in reverse list order
+	back *PersistentNode
+
+	len int32
+}
+
+// Prepend will return a new version of the list with the provided values prepended
+func (l *PersistentList) Prepend(vals ...int64) (nl *PersistentList) {
+	nl = &PersistentList{front: l.front, back: l.back, len: l.len}
+	// Iterate through provided values
+	for _, val := range vals {
+		// Link a new node in front of the existing front chain
+		nl.front = newPersistentNode(nl.front, val)
+		nl.len++
+	}
+
+	return
+}
+
+// Append will return a new version of the list with the provided values appended
+func (l *PersistentList) Append(vals ...int64) (nl *PersistentList) {
+	nl = &PersistentList{front: l.front, back: l.back, len: l.len}
+	// Iterate through provided values
+	for _, val := range vals {
+		// Link a new node in front of the existing back chain
+		nl.back = newPersistentNode(nl.back, val)
+		nl.len++
+	}
+
+	return
+}
+
+// Remove will return a new version of the list with the provided node removed
+// Note: If the node does not belong to the list, the list is returned as-is
+func (l *PersistentList) Remove(n *PersistentNode) (nl *PersistentList) {
+	if front, ok := removePersistentNode(l.front, n); ok {
+		// Node was found within the front chain, the back chain is shared as-is
+		return &PersistentList{front: front, back: l.back, len: l.len - 1}
+	}
+
+	if back, ok := removePersistentNode(l.back, n); ok {
+		// Node was found within the back chain, the front chain is shared as-is
+		return &PersistentList{front: l.front, back: back, len: l.len - 1}
+	}
+
+	return l
+}
+
+// Map will return a new mapped list
+func (l *PersistentList) Map(fn MapFn) (nl *PersistentList) {
+	nl = &PersistentList{len: l.len}
+	// Mapped values are collected so they can be linked into the front chain, iterating over
+	// the front chain does not require the back chain to be reversed
+	nvals := make([]int64, 0, l.len)
+	l.ForEach(func(_ *PersistentNode, val int64) bool {
+		nvals = append(nvals, fn(val))
+		return false
+	})
+
+	// Iterate through the mapped values in reverse, linking each in front of the front chain
+	for i := len(nvals) - 1; i >= 0; i-- {
+		nl.front = newPersistentNode(nl.front, nvals[i])
+	}
+
+	return
+}
+
+// Reduce will return a reduced value
+func (l *PersistentList) Reduce(fn ReduceFn) (sum int64) {
+	// Iterate through each item
+	l.ForEach(func(_ *PersistentNode, val int64) bool {
+		sum = fn(sum, val)
+		return false
+	})
+
+	return
+}
+
+// ForEach will iterate through each node within the list
+func (l *PersistentList) ForEach(fn PersistentForEachFn) (ended bool) {
+	// Iterate through the front chain
+	for n := l.front; n != nil; n = n.next {
+		if fn(n, n.val) {
+			// Func returned true, return with ended as true
+			return true
+		}
+	}
+
+	if l.back == nil {
+		// No back chain exists, return early
+		return false
+	}
+
+	// The back chain is stored in reverse, so we collect it before iterating
+	back := make([]*PersistentNode, 0, l.len)
+	for n := l.back; n != nil; n = n.next {
+		back = append(back, n)
+	}
+
+	// Iterate through the back chain in reverse
+	for i := len(back) - 1; i >= 0; i-- {
+		if fn(back[i], back[i].val) {
+			// Func returned true, return with ended as true
+			return true
+		}
+	}
+
+	return false
+}
+
+// Slice will return a slice of the current list
+func (l *PersistentList) Slice() (s []int64) {
+	s = make([]int64, 0, l.len)
+	l.ForEach(func(_ *PersistentNode, val int64) bool {
+		s = append(s, val)
+		return false
+	})
+
+	return
+}
+
+// Val will return the value for a given node
+func (l *PersistentList) Val(n *PersistentNode) (val int64) {
+	return n.val
+}
+
+// Len will return the current length of the list
+func (l *PersistentList) Len() (n int32) {
+	return l.len
+}
+
+// removePersistentNode will return a chain with the target node omitted, the nodes following
+// the target are shared while the nodes preceding it are copied
+func removePersistentNode(head, target *PersistentNode) (nhead *PersistentNode, ok bool) {
+	// Nodes which precede the target
+	var prefix []*PersistentNode
+	for n := head; n != nil; n = n.next {
+		if n != target {
+			prefix = append(prefix, n)
+			continue
+		}
+
+		// Set the new head as the chain following the target
+		nhead = n.next
+		// Copy the preceding nodes in front of the shared chain
+		for i := len(prefix) - 1; i >= 0; i-- {
+			nhead = newPersistentNode(nhead, prefix[i].val)
+		}
+
+		return nhead, true
+	}
+
+	return head, false
+}
+
+func newPersistentNode(next *PersistentNode, val int64) *PersistentNode {
+	return &PersistentNode{next, val}
+}
+
+// PersistentNode is an immutable value container
+type PersistentNode struct {
+	next *PersistentNode
+
+	val int64
+}
+
+// PersistentForEachFn is the format of the function used to call PersistentList.ForEach
+type PersistentForEachFn func(n *PersistentNode, val int64) (end bool)
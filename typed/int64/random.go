@@ -0,0 +1,88 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+import "math/rand"
+
+// Shuffle will randomly reorder the list in place, the nodes are relinked rather than copied
+func (l *LinkedList) Shuffle(rng *rand.Rand) {
+	nodes := make([]*Node, 0, l.len)
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, _ int64) bool {
+		nodes = append(nodes, n)
+		return false
+	})
+
+	rng.Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+
+	// Relink the nodes in their shuffled order
+	l.head, l.tail, l.len = nil, nil, 0
+	for _, n := range nodes {
+		l.appendNode(n)
+	}
+}
+
+// Sample will return a list of k randomly selected values using reservoir sampling in a single pass
+// Note: When the list has k or fewer values, every value is returned
+func (l *LinkedList) Sample(k int, rng *rand.Rand) (nl *LinkedList) {
+	nl = &LinkedList{reporter: true}
+	if k < 1 {
+		return
+	}
+
+	// The reservoir will never hold more values than the list, so the capacity is bounded by the list length
+	size := k
+	if int(l.len) < size {
+		size = int(l.len)
+	}
+
+	reservoir := make([]int64, 0, size)
+	// Number of seen values
+	var seen int
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val int64) bool {
+		if seen++; len(reservoir) < k {
+			// Reservoir is not full, add the value
+			reservoir = append(reservoir, val)
+			return false
+		}
+
+		if i := rng.Intn(seen); i < k {
+			// Replace a reservoir value
+			reservoir[i] = val
+		}
+
+		return false
+	})
+
+	nl.Append(reservoir...)
+	return
+}
+
+// RandomNode will return a randomly selected node, nil is returned when the list is empty
+func (l *LinkedList) RandomNode(rng *rand.Rand) (n *Node) {
+	if l.len == 0 {
+		return
+	}
+
+	i := int32(rng.Intn(int(l.len)))
+	if i < l.len/2 {
+		// Node is within the first half, walk forward from the head
+		for n = l.head; i > 0; i-- {
+			n = n.next
+		}
+
+		return
+	}
+
+	// Node is within the second half, walk backward from the tail
+	for n = l.tail; i < l.len-1; i++ {
+		n = n.prev
+	}
+
+	return
+}
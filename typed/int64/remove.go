@@ -0,0 +1,57 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// RemoveIf will remove each node whose value matches the provided func, the number of removed nodes is Returned
+func (l *LinkedList) RemoveIf(fn FilterFn) (removed int) {
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, val int64) bool {
+		if fn(val) {
+			l.Remove(n)
+			removed++
+		}
+
+		return false
+	})
+
+	return
+}
+
+// Extract will move each node whose value matches the provided func into a new list, the nodes are not copied
+// Note: Cursors positioned on extracted nodes move before the head, iterators positioned on extracted nodes are exhausted
+func (l *LinkedList) Extract(fn FilterFn) (nl *LinkedList) {
+	nl = &LinkedList{}
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, val int64) bool {
+		if fn(val) {
+			l.unlink(n)
+			nl.appendNode(n)
+		}
+
+		return false
+	})
+
+	return
+}
+
+// RemoveRange will remove each node from the from node through the to node (inclusive), the number of removed nodes is Returned
+// Note: A nil from node will remove from the head, a nil to node will remove through the tail. The to node
+// must not precede the from node. Nothing is removed when either node does not belong to the list
+func (l *LinkedList) RemoveRange(from, to *Node) (removed int) {
+	if from != nil && from.list != l || to != nil && to.list != l {
+		// Range does not belong to this list, its links must not be followed
+		return
+	}
+
+	// Iterate through each item starting at from
+	l.ForEach(from, func(n *Node, _ int64) bool {
+		l.Remove(n)
+		removed++
+		// End iteration once the to node has been removed
+		return n == to
+	})
+
+	return
+}
@@ -0,0 +1,16 @@
+package linkedlist
+
+import (
+	"strconv"
+	"strings"
+)
+
+// formatSQLVal will format a value as a Postgres array element
+func formatSQLVal(val int64) (str string, quote bool) {
+	return strconv.FormatInt(val, 10), false
+}
+
+// parseSQLVal will parse a value from a Postgres array element
+func parseSQLVal(raw string, quoted bool) (val int64, err error) {
+	return strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+}
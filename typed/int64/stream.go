@@ -0,0 +1,151 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package linkedlist
+
+// Stream will return a lazy stream of the list values
+func (l *LinkedList) Stream() (s *Stream) {
+	return &Stream{list: l}
+}
+
+// Stream is a lazy pipeline of stages, the stages are fused and run in a single pass when a terminal
+// operation (ForEach, Reduce, Slice or Collect) is called. Each stage returns a new stream.
+type Stream struct {
+	list *LinkedList
+	// Stage constructors, called at the start of each run so stateful stages are reset
+	stages []func() streamStage
+}
+
+// with will return a new stream with the provided stage appended
+func (s *Stream) with(stage func() streamStage) (ns *Stream) {
+	ns = &Stream{list: s.list}
+	ns.stages = make([]func() streamStage, len(s.stages), len(s.stages)+1)
+	copy(ns.stages, s.stages)
+	ns.stages = append(ns.stages, stage)
+	return
+}
+
+// run will pass each value which makes it through every stage to the provided func
+func (s *Stream) run(fn StreamForEachFn) (ended bool) {
+	stages := make([]streamStage, len(s.stages))
+	for i, stage := range s.stages {
+		stages[i] = stage()
+	}
+
+	s.list.ForEach(nil, func(_ *Node, val int64) bool {
+		var keep, stop, last bool
+		// Iterate through each stage
+		for _, stage := range stages {
+			if val, keep, stop = stage(val); stop {
+				// Stage has ended the stream, this is the last value to be pulled
+				last = true
+			}
+
+			if !keep {
+				// Value was dropped, move on to the next value unless the stream has ended
+				return last
+			}
+		}
+
+		// Call provided func
+		ended = fn(val)
+		return ended || last
+	})
+
+	return
+}
+
+// Map will return a stream with a map stage appended
+func (s *Stream) Map(fn MapFn) (ns *Stream) {
+	return s.with(func() streamStage {
+		return func(val int64) (int64, bool, bool) {
+			return fn(val), true, false
+		}
+	})
+}
+
+// Filter will return a stream with a filter stage appended
+func (s *Stream) Filter(fn FilterFn) (ns *Stream) {
+	return s.with(func() streamStage {
+		return func(val int64) (int64, bool, bool) {
+			return val, fn(val), false
+		}
+	})
+}
+
+// Take will return a stream which ends after n values
+// Note: The stream ends as the nth value is taken, so no further values are pulled through the earlier stages
+func (s *Stream) Take(n int) (ns *Stream) {
+	if n <= 0 {
+		// No values will be taken, return a stream over an empty list so no values are pulled
+		return &Stream{list: &LinkedList{}}
+	}
+
+	return s.with(func() streamStage {
+		var taken int
+		return func(val int64) (int64, bool, bool) {
+			taken++
+			// End the stream once we have taken all of our values
+			return val, true, taken == n
+		}
+	})
+}
+
+// Skip will return a stream which drops the first n values
+func (s *Stream) Skip(n int) (ns *Stream) {
+	return s.with(func() streamStage {
+		var skipped int
+		return func(val int64) (int64, bool, bool) {
+			if skipped < n {
+				skipped++
+				return val, false, false
+			}
+
+			return val, true, false
+		}
+	})
+}
+
+// ForEach will run the stream, calling the provided func for each resulting value
+func (s *Stream) ForEach(fn StreamForEachFn) (ended bool) {
+	return s.run(fn)
+}
+
+// Reduce will run the stream and return a reduced value
+func (s *Stream) Reduce(fn ReduceFn) (sum int64) {
+	s.run(func(val int64) bool {
+		sum = fn(sum, val)
+		return false
+	})
+
+	return
+}
+
+// Slice will run the stream and return a slice of the resulting values
+func (s *Stream) Slice() (vals []int64) {
+	s.run(func(val int64) bool {
+		vals = append(vals, val)
+		return false
+	})
+
+	return
+}
+
+// Collect will run the stream and return a new list of the resulting values
+func (s *Stream) Collect() (nl *LinkedList) {
+	nl = &LinkedList{}
+	s.run(func(val int64) bool {
+		nl.append(val)
+		return false
+	})
+
+	return
+}
+
+// streamStage is a single stage of a stream, keep reports if the value continues
+// through the stream and stop reports if the stream ends after the value
+type streamStage func(val int64) (out int64, keep, stop bool)
+
+// StreamForEachFn is the format of the function used to call Stream.ForEach
+type StreamForEachFn func(val int64) (end bool)
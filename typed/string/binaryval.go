@@ -0,0 +1,31 @@
+package linkedlist
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// writeBinaryVal will write a value as a varint length prefixed string
+func writeBinaryVal(w io.Writer, buf []byte, val string) (err error) {
+	if _, err = w.Write(buf[:binary.PutUvarint(buf, uint64(len(val)))]); err != nil {
+		return
+	}
+
+	_, err = io.WriteString(w, val)
+	return
+}
+
+// readBinaryVal will read a value from a varint length prefixed string
+func readBinaryVal(r binaryReader) (val string, err error) {
+	var length uint64
+	if length, err = binary.ReadUvarint(r); err != nil {
+		return
+	}
+
+	var bs []byte
+	if bs, err = readBinaryBytes(r, length); err != nil {
+		return
+	}
+
+	return string(bs), nil
+}
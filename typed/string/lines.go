@@ -0,0 +1,101 @@
+package linkedlist
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// ReadLines will read a list from a reader, each token produced by the scanner is appended as a value
+func ReadLines(r io.Reader, opts ReadLinesOpts) (l *LinkedList, err error) {
+	scn := bufio.NewScanner(r)
+	if opts.Split != nil {
+		scn.Split(opts.Split)
+	}
+
+	if opts.MaxTokenSize > 0 {
+		// Start with the default buffer size, allowing growth up to our max token size
+		initial := 4096
+		if opts.MaxTokenSize < initial {
+			initial = opts.MaxTokenSize
+		}
+
+		scn.Buffer(make([]byte, 0, initial), opts.MaxTokenSize)
+	}
+
+	l = &LinkedList{}
+	// Iterate through each token
+	for scn.Scan() {
+		l.append(scn.Text())
+	}
+
+	if err = scn.Err(); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// WriteLines will write each value to a writer, each value is followed by the provided separator
+func (l *LinkedList) WriteLines(w io.Writer, sep string) (n int64, err error) {
+	bw := bufio.NewWriter(w)
+	// Iterate through each item
+	l.ForEach(nil, func(_ *Node, val string) bool {
+		var written int
+		if written, err = bw.WriteString(val); err != nil {
+			return true
+		}
+
+		n += int64(written)
+		if written, err = bw.WriteString(sep); err != nil {
+			return true
+		}
+
+		n += int64(written)
+		return false
+	})
+
+	if err != nil {
+		return
+	}
+
+	err = bw.Flush()
+	return
+}
+
+// Join will return the values of the list joined by the provided separator
+func (l *LinkedList) Join(sep string) (joined string) {
+	if l.len == 0 {
+		return
+	}
+
+	// Pre-sum the lengths so the builder is allocated exactly once
+	size := len(sep) * int(l.len-1)
+	l.ForEach(nil, func(_ *Node, val string) bool {
+		size += len(val)
+		return false
+	})
+
+	var sb strings.Builder
+	sb.Grow(size)
+	// Iterate through each item
+	l.ForEach(nil, func(n *Node, val string) bool {
+		if n != l.head {
+			// This is not the first item, write the separator
+			sb.WriteString(sep)
+		}
+
+		sb.WriteString(val)
+		return false
+	})
+
+	return sb.String()
+}
+
+// ReadLinesOpts are the options used when reading lines
+type ReadLinesOpts struct {
+	// Split is the split function used to tokenize the reader, nil defaults to bufio.ScanLines
+	Split bufio.SplitFunc
+	// MaxTokenSize is the maximum size of a single token, zero defaults to bufio.MaxScanTokenSize
+	MaxTokenSize int
+}
@@ -0,0 +1,51 @@
+package linkedlist
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadLines(t *testing.T) {
+	l, err := ReadLines(strings.NewReader("foo\nbar\r\nbaz"), ReadLinesOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if joined := l.Join(","); joined != "foo,bar,baz" {
+		t.Fatalf("invalid value, expected %q and received %q", "foo,bar,baz", joined)
+	}
+
+	if l, err = ReadLines(strings.NewReader("a quick  fox"), ReadLinesOpts{Split: bufio.ScanWords}); err != nil {
+		t.Fatal(err)
+	}
+
+	if l.Len() != 3 {
+		t.Fatalf("invalid length, expected %v and received %v", 3, l.Len())
+	}
+
+	if _, err = ReadLines(strings.NewReader("short\nmuch too long"), ReadLinesOpts{MaxTokenSize: 8}); err != bufio.ErrTooLong {
+		t.Fatalf("invalid error, expected %v and received %v", bufio.ErrTooLong, err)
+	}
+}
+
+func TestWriteLines(t *testing.T) {
+	var l LinkedList
+	l.Append("foo", "", "bar")
+
+	var buf bytes.Buffer
+	n, err := l.WriteLines(&buf, "\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "foo\n\nbar\n"; buf.String() != expected || n != int64(len(expected)) {
+		t.Fatalf("invalid value, expected %q and received %q", expected, buf.String())
+	}
+
+	var empty LinkedList
+	if joined := empty.Join(","); joined != "" {
+		t.Fatalf("invalid value, expected %q and received %q", "", joined)
+	}
+}
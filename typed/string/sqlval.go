@@ -0,0 +1,18 @@
+package linkedlist
+
+import "strings"
+
+// formatSQLVal will format a value as a Postgres array element
+func formatSQLVal(val string) (str string, quote bool) {
+	return val, true
+}
+
+// parseSQLVal will parse a value from a Postgres array element
+func parseSQLVal(raw string, quoted bool) (val string, err error) {
+	if !quoted {
+		// Unquoted elements have their surrounding whitespace ignored
+		return strings.TrimSpace(raw), nil
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,50 @@
+package linkedlist
+
+import (
+	"testing"
+
+	int64list "github.com/itsmontoya/linkedlist/typed/int64"
+)
+
+func TestZip(t *testing.T) {
+	add := func(a, b GenericVal) GenericVal {
+		return a.(int) + b.(int)
+	}
+
+	a := New(1, 2, 3)
+	b := New(10, 20)
+	if err := testCompareInts(testToInts(Zip(a, b, add).Slice()), []int{11, 22}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testCompareInts(testToInts(ZipFill(a, b, 0, add).Slice()), []int{11, 22, 3}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	nl := Interleave(New(0, 3, 5), New(), New(1, 4), New(2))
+	if err := testCompareInts(testToInts(nl.Slice()), []int{0, 1, 2, 3, 4, 5}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestForEachPair(t *testing.T) {
+	l := int64list.New(100, 105, 103, 110)
+
+	var deltas []int64
+	l.ForEachPair(func(prev, cur *int64list.Node) bool {
+		deltas = append(deltas, l.Val(cur)-l.Val(prev))
+		return false
+	})
+
+	if len(deltas) != 3 || deltas[0] != 5 || deltas[1] != -2 || deltas[2] != 7 {
+		t.Fatalf("invalid deltas, expected %v and received %v", []int64{5, -2, 7}, deltas)
+	}
+
+	var single LinkedList
+	single.Append(1)
+	if single.ForEachPair(func(_, _ *Node) bool { return true }) {
+		t.Fatal("expected a single value list to have no pairs")
+	}
+}